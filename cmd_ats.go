@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/odds"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runATS(args []string) {
+	fs := newFlagSet("ats", "-odds lines.csv [-season 2023-24 | -start-date ... -end-date ...]")
+	query := addQueryFlags(fs)
+	oddsFile := fs.String("odds", "", "Closing lines CSV or JSON file (required)")
+	outputFile := fs.String("output", "ats.json", "Output JSON file path")
+	excelFile := fs.String("excel", "ats.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *oddsFile == "" {
+		fs.Usage()
+		log.Fatalf("Error: -odds is required")
+	}
+	lines, err := odds.Load(*oddsFile)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	matched := odds.Apply(games, lines)
+	ats := odds.BuildReport(dateService.League(), games, len(lines), matched, period)
+	printATS(ats)
+
+	if err := saveJSON(ats, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateATSReport(ats, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// addOdds joins the closing lines in an odds file to games, settling the
+// final ones
+func addOdds(path string, games []nba.Game) error {
+	lines, err := odds.Load(path)
+	if err != nil {
+		return err
+	}
+	matched := odds.Apply(games, lines)
+	fmt.Printf("Joined closing lines to %d of %d games\n", matched, len(games))
+	return nil
+}
+
+func printATS(ats *odds.Report) {
+	summary := ats.Summary
+	fmt.Printf("\nAgainst the Spread (%s)\n", ats.Period)
+	fmt.Printf("  %d games given a line from the %d in the file; %d settled against the spread\n", ats.Matched, ats.Lines, summary.Games)
+	if summary.Games == 0 && len(ats.Games) == 0 {
+		fmt.Println()
+		return
+	}
+	fmt.Printf("  Home teams %s ATS, favorites %s ATS, over/under %s\n\n",
+		summary.Home, summary.Favorites, summary.Totals)
+
+	fmt.Printf("  %-4s %-24s %3s %-8s %6s %-8s %-8s %-8s %-8s %6s  %s\n",
+		"Team", "Name", "GP", "ATS", "Pct", "Home", "Road", "Fav", "Dog", "Cover", "O-U-P")
+	for _, team := range ats.Teams {
+		fmt.Printf("  %-4s %-24s %3d %-8s %6.3f %-8s %-8s %-8s %-8s %+6.1f  %s\n",
+			team.Team, team.Name, team.Games, team.ATS, team.Pct, team.Home, team.Road,
+			team.Favorite, team.Underdog, team.CoverMargin, team.Totals)
+	}
+	fmt.Println()
+}
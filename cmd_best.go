@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+func runBest(args []string) {
+	fs := newFlagSet("best", "[-date 2024-01-15] [-week] [-top 5] [-no-spoilers]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	date := fs.String("date", "", "Date in YYYY-MM-DD format (default: yesterday)")
+	week := fs.Bool("week", false, "Rank the seven days ending on -date instead of a single day")
+	top := fs.Int("top", 5, "Number of games to list")
+	noSpoilers := fs.Bool("no-spoilers", false, "List the games without scores, margins or other rating details")
+	outputFile := fs.String("output", "best_games.json", "Output JSON file path")
+	excelFile := fs.String("excel", "best_games.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *date == "" {
+		*date = time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	end, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		log.Fatalf("Error: invalid date format '%s': use YYYY-MM-DD format", *date)
+	}
+
+	start, period := end, *date
+	if *week {
+		start = end.AddDate(0, 0, -6)
+		period = fmt.Sprintf("%s to %s", start.Format("2006-01-02"), *date)
+	}
+
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	fmt.Printf("Fetching %s games for %s...\n", league.Name, period)
+	games, err := dateService.GetGamesBetween(start.Format("2006-01-02"), *date)
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", league.Name, err)
+	}
+
+	addWatchability(dateService, games)
+	best := stats.BestGames(games, *top)
+
+	if *noSpoilers {
+		printSpoilerFreeBestGames(best, period)
+		err = saveJSON(nba.SpoilerFreeGames(best), *outputFile)
+	} else {
+		printBestGames(best, period)
+		err = saveJSON(best, *outputFile)
+	}
+	if err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	reporter.SetSpoilerFree(*noSpoilers)
+	if err := reporter.GenerateBestGamesReport(best, period, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// addWatchability rates every completed game, using play-by-play where it
+// can be fetched and the linescore otherwise
+func addWatchability(dateService *nba.DateService, games []nba.Game) {
+	for i := range games {
+		if !games[i].IsFinal() {
+			continue
+		}
+		// Without play-by-play the rating falls back to the linescore
+		playByPlay, _ := dateService.GetPlayByPlay(games[i])
+		watchability := stats.RateWatchability(games[i], playByPlay, dateService.League())
+		games[i].Watchability = &watchability
+	}
+}
+
+func printBestGames(games []nba.Game, period string) {
+	fmt.Printf("\nBest Games (%s)\n", period)
+	for i, game := range games {
+		w := game.Watchability
+		fmt.Printf("  %d. %s %s %d @ %s %d  %5.1f  (margin %d, %d lead changes, %d ties",
+			i+1, game.Date, game.AwayTeam.Code, game.AwayTeam.Score, game.HomeTeam.Code, game.HomeTeam.Score,
+			w.Score, w.FinalMargin, w.LeadChanges, w.Ties)
+		if w.Overtimes > 0 {
+			fmt.Printf(", %dOT", w.Overtimes)
+		}
+		fmt.Println(")")
+	}
+	fmt.Println()
+}
+
+// printSpoilerFreeBestGames lists the best games by watchability alone
+func printSpoilerFreeBestGames(games []nba.Game, period string) {
+	fmt.Printf("\nBest Games (%s, spoiler-free)\n", period)
+	for i, game := range games {
+		fmt.Printf("  %d. %s %s @ %s  %5.1f\n", i+1, game.Date, game.AwayTeam.Code, game.HomeTeam.Code, game.Watchability.Score)
+	}
+	fmt.Println()
+}
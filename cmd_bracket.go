@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/pool"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runBracket(args []string) {
+	fs := newFlagSet("bracket", "-picks bracket.csv [-season 2023-24] [-weights 1,2,4,8]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	season := fs.String("season", "", "Playoffs to score, e.g. 2023-24 (default: current season)")
+	picksFile := fs.String("picks", "", "Bracket picks JSON, CSV or Excel file (required)")
+	sheet := fs.String("sheet", "", "Sheet of an Excel picks file (default: the first)")
+	weights := fs.String("weights", "", "Points for a correct series winner in each round (default: 1,2,4,... doubling)")
+	gameBonus := fs.Int("game-bonus", 1, "Extra points for also picking the series length")
+	entryName := fs.String("entry", "", "Print this participant's picks one by one")
+	outputFile := fs.String("output", "bracket.json", "Output JSON file path")
+	excelFile := fs.String("excel", "bracket.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *picksFile == "" {
+		fs.Usage()
+		log.Fatalf("Error: -picks is required")
+	}
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *season == "" {
+		*season = league.SeasonForDate(time.Now())
+	}
+	config := pool.DefaultBracketConfig(league)
+	config.GameBonus = *gameBonus
+	if *weights != "" {
+		config.Weights = nil
+		for _, weight := range nba.ParseList(*weights) {
+			points, err := strconv.Atoi(weight)
+			if err != nil || points < 0 {
+				log.Fatalf("Error: invalid round weight '%s': use whole points, e.g. 1,2,4,8", weight)
+			}
+			config.Weights = append(config.Weights, points)
+		}
+	}
+
+	picks, err := pool.LoadBracket(*picksFile, *sheet)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	playoffStart, err := league.PlayoffStart(*season)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	_, seasonEnd, err := league.SeasonDates(*season)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if today := time.Now(); today.Before(seasonEnd) {
+		seasonEnd = today
+	}
+
+	var games []nba.Game
+	if playoffStart.After(seasonEnd) {
+		fmt.Printf("The %s %s playoffs have not started; every pick is pending.\n", league.Name, *season)
+	} else {
+		fmt.Printf("Fetching %s %s playoff games...\n", league.Name, *season)
+		dateService := nba.NewDateService(nba.NewLeagueClient(league))
+		games, err = dateService.GetScheduleBetween(playoffStart.Format("2006-01-02"), seasonEnd.Format("2006-01-02"))
+		if err != nil {
+			log.Fatalf("Error fetching %s games: %v", league.Name, err)
+		}
+	}
+
+	results, err := pool.ScoreBracket(league, picks, pool.PlayoffSeries(league, games), config)
+	if err != nil {
+		log.Fatalf("Error scoring brackets: %v", err)
+	}
+	results.Season = *season
+	printBracket(results)
+	if *entryName != "" {
+		entry, ok := results.Entry(*entryName)
+		if !ok {
+			log.Fatalf("Error: no bracket from '%s'", *entryName)
+		}
+		printBracketEntry(entry)
+	}
+
+	if err := saveJSON(results, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateBracketReport(results, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printBracket(results *pool.BracketReport) {
+	fmt.Printf("\nBracket Challenge (%s playoffs)\n", results.Season)
+	for _, series := range results.Series {
+		fmt.Printf("  %-22s %s\n", series.Name, series.Summary())
+	}
+
+	fmt.Printf("\n  %4s %-20s %6s %9s %4s %7s %5s %7s %7s  %s\n",
+		"Rank", "Participant", "Points", "Remaining", "Max", "Correct", "Wrong", "Pending", "Lengths", "Champion")
+	for _, entry := range results.Entries {
+		fmt.Printf("  %4d %-20s %6d %9d %4d %7d %5d %7d %7d  %s\n",
+			entry.Rank, entry.Participant, entry.Points, entry.Remaining, entry.MaxPoints,
+			entry.Correct, entry.Wrong, entry.Pending, entry.GamesCorrect, entry.Champion)
+	}
+	fmt.Println()
+}
+
+func printBracketEntry(entry pool.Entry) {
+	fmt.Printf("%s: %d points, up to %d\n", entry.Participant, entry.Points, entry.MaxPoints)
+	for _, pick := range entry.Picks {
+		games := ""
+		if pick.Games > 0 {
+			games = fmt.Sprintf("in %d", pick.Games)
+		}
+		fmt.Printf("  %-22s %-4s %-5s %-8s %3d pts  %s",
+			pick.RoundName, pick.Winner, games, pick.Result, pick.Points, pick.Series)
+		if pick.Possible > 0 {
+			fmt.Printf(" (up to %d more)", pick.Possible)
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+}
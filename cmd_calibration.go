@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/ratings"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+// predictionLookbackDays is how much history is used to rate teams before predicting
+const predictionLookbackDays = 365
+
+func runCalibration(args []string) {
+	fs := newFlagSet("calibration", "[-season 2023-24 | -start-date ... -end-date ...] [options]")
+	query := addQueryFlags(fs)
+	buckets := fs.Int("buckets", ratings.DefaultCalibrationBuckets, "Number of reliability buckets")
+	outputFile := fs.String("output", "calibration.json", "Output JSON file path")
+	excelFile := fs.String("excel", "calibration.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *buckets <= 0 {
+		log.Fatalf("Error: -buckets must be positive")
+	}
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	// Each game is forecast from the ratings before it, then used to update them
+	engine := ratings.NewEloEngine(dateService.League(), ratings.DefaultEloConfig())
+	engine.Process(games)
+	calibration := ratings.Calibrate(engine.Outcomes(), *buckets)
+
+	printCalibration(calibration, period)
+
+	if err := saveJSON(calibration, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateCalibrationReport(calibration, engine.Outcomes(), period, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// predictGames rates every team on the games before dateStr and attaches a
+// prediction to each scheduled game
+func predictGames(league nba.League, dateStr string, games []nba.Game) error {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return fmt.Errorf("invalid date format '%s': use YYYY-MM-DD format: %w", dateStr, err)
+	}
+
+	// Ratings use every game, not just the filtered ones being predicted
+	history := nba.NewDateService(nba.NewLeagueClient(league))
+	start := date.AddDate(0, 0, -predictionLookbackDays).Format("2006-01-02")
+	end := date.AddDate(0, 0, -1).Format("2006-01-02")
+	past, err := history.GetGamesBetween(start, end)
+	if err != nil {
+		return err
+	}
+
+	engine := ratings.NewEloEngine(league, ratings.DefaultEloConfig())
+	engine.Process(past)
+	engine.PredictGames(games)
+	return nil
+}
+
+func printPredictions(games []nba.Game) {
+	fmt.Println("\nPredictions:")
+	for _, game := range games {
+		if game.Prediction == nil {
+			continue
+		}
+		fmt.Printf("  %s @ %s: %s %.0f%% (expected margin %+.1f)\n",
+			game.AwayTeam.Code, game.HomeTeam.Code,
+			game.Prediction.PredictedWinner, winnerProbability(game.Prediction)*100,
+			game.Prediction.ExpectedMargin)
+	}
+}
+
+// winnerProbability returns the predicted winner's win probability
+func winnerProbability(prediction *nba.Prediction) float64 {
+	if prediction.AwayWinProbability > prediction.HomeWinProbability {
+		return prediction.AwayWinProbability
+	}
+	return prediction.HomeWinProbability
+}
+
+func printCalibration(calibration *ratings.CalibrationReport, period string) {
+	fmt.Printf("\nPrediction Calibration (%s)\n", period)
+	fmt.Printf("  Games:       %d\n", calibration.Games)
+	fmt.Printf("  Brier score: %.4f (baseline %.4f)\n", calibration.BrierScore, calibration.BaselineBrier)
+	fmt.Printf("  Log loss:    %.4f\n", calibration.LogLoss)
+	fmt.Printf("  Accuracy:    %.1f%%\n", calibration.Accuracy*100)
+	fmt.Printf("  Margin MAE:  %.1f\n", calibration.MarginMAE)
+	fmt.Println("\n  Predicted    Games  Mean   Observed")
+	for _, bucket := range calibration.Reliability {
+		if bucket.Games == 0 {
+			continue
+		}
+		fmt.Printf("  %3.0f-%3.0f%%  %6d  %4.0f%%  %4.0f%%\n",
+			bucket.Lower*100, bucket.Upper*100, bucket.Games,
+			bucket.MeanPredicted*100, bucket.ObservedRate*100)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+func runComebacks(args []string) {
+	fs := newFlagSet("comebacks", "[-season 2023-24 | -start-date ... -end-date ...] [-top 10] [-min 10]")
+	query := addQueryFlags(fs)
+	top := fs.Int("top", 10, "Number of comebacks to list; 0 lists all")
+	minDeficit := fs.Int("min", 1, "Smallest deficit that counts as a comeback")
+	outputFile := fs.String("output", "comebacks.json", "Output JSON file path")
+	excelFile := fs.String("excel", "comebacks.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	addFlow(dateService, games)
+	comebacks := stats.BiggestComebacks(games, *minDeficit, *top)
+	printComebacks(comebacks, period)
+
+	if err := saveJSON(comebacks, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateComebacksReport(comebacks, games, period, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// addFlow works out the flow of every completed game whose play-by-play can
+// be fetched
+func addFlow(dateService *nba.DateService, games []nba.Game) {
+	analyzed := 0
+	for i := range games {
+		if !games[i].IsFinal() {
+			continue
+		}
+		playByPlay, err := dateService.GetPlayByPlay(games[i])
+		if err != nil {
+			continue
+		}
+		if games[i].Flow = stats.AnalyzeFlow(games[i], playByPlay, dateService.League()); games[i].Flow != nil {
+			analyzed++
+		}
+	}
+	fmt.Printf("Analyzed the flow of %d of %d games\n", analyzed, len(games))
+}
+
+func printComebacks(games []nba.Game, period string) {
+	fmt.Printf("\nBiggest Comebacks (%s)\n", period)
+	if len(games) == 0 {
+		fmt.Println("  No comebacks found.")
+	}
+	for i, game := range games {
+		comeback := game.Flow.Comeback
+		team, opponent := game.TeamAndOpponent(comeback.Team)
+		fmt.Printf("  %2d. %s %s from %d down (%s) to beat %s %d-%d, %d lead changes\n",
+			i+1, game.Date, team.Code, comeback.Deficit, nba.PeriodClock(comeback.Period, comeback.Clock),
+			opponent.Code, team.Score, opponent.Score, game.Flow.LeadChanges)
+	}
+	fmt.Println()
+}
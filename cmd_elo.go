@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/ratings"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runElo(args []string) {
+	defaults := ratings.DefaultEloConfig()
+
+	fs := newFlagSet("elo", "[-season 2023-24 | -start-date ... -end-date ...] [options]")
+	query := addQueryFlags(fs)
+	kFactor := fs.Float64("k", defaults.KFactor, "K-factor: how far one result moves a rating")
+	homeAdvantage := fs.Float64("home-advantage", defaults.HomeAdvantage, "Elo points added to the home team")
+	carryOver := fs.Float64("carry-over", defaults.SeasonCarryOver, "Share of a rating's distance from the mean kept between seasons")
+	noMargin := fs.Bool("no-margin", false, "Ignore the margin of victory")
+	outputFile := fs.String("output", "elo.json", "Output JSON file path")
+	historyCSV := fs.String("history-csv", "elo_history.csv", "Output CSV file path for rating histories")
+	rankingsCSV := fs.String("rankings-csv", "elo_rankings.csv", "Output CSV file path for power rankings")
+	excelFile := fs.String("excel", "elo.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	config := defaults
+	config.KFactor = *kFactor
+	config.HomeAdvantage = *homeAdvantage
+	config.SeasonCarryOver = *carryOver
+	config.MarginOfVictory = !*noMargin
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	engine := ratings.NewEloEngine(dateService.League(), config)
+	engine.Process(games)
+	ratingsReport := engine.Report(period)
+
+	printRankings(ratingsReport)
+
+	if err := saveJSON(ratingsReport, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	if err := report.WriteRatingHistoryCSV(ratingsReport.History, *historyCSV); err != nil {
+		log.Fatalf("Error saving rating history CSV: %v", err)
+	}
+	if err := report.WriteRankingsCSV(ratingsReport.Rankings, *rankingsCSV); err != nil {
+		log.Fatalf("Error saving rankings CSV: %v", err)
+	}
+	fmt.Printf("CSV results saved to: %s, %s\n", *historyCSV, *rankingsCSV)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateRatingsReport(ratingsReport.Rankings, ratingsReport.History, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printRankings(ratingsReport *ratings.RatingsReport) {
+	fmt.Printf("\nElo Power Rankings (%s)\n", ratingsReport.Period)
+	for _, ranking := range ratingsReport.Rankings {
+		fmt.Printf("  %2d. %-4s %7.1f  %d-%d\n", ranking.Rank, ranking.Team, ranking.Rating, ranking.Wins, ranking.Losses)
+	}
+	fmt.Println()
+}
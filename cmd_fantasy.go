@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/fantasy"
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runFantasy(args []string) {
+	fs := newFlagSet("fantasy", "[-season 2023-24 | -start-date ... -end-date ...] [-profile 9cat] [-config scoring.json]")
+	query := addQueryFlags(fs)
+	configFile := fs.String("config", "", "Scoring profiles JSON file (default: built-in standard, espn and 9cat)")
+	profileName := fs.String("profile", fantasy.DefaultProfile, "Scoring profile to rank with")
+	top := fs.Int("top", fantasy.DefaultTopPlayers, "Players ranked over the period")
+	daily := fs.Int("daily", fantasy.DefaultDailyPlayers, "Best fantasy games listed for each day")
+	outputFile := fs.String("output", "fantasy.json", "Output JSON file path")
+	excelFile := fs.String("excel", "fantasy.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *top <= 0 {
+		log.Fatalf("Error: -top must be positive")
+	}
+	profile := loadProfile(*configFile, *profileName)
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	boxScores, err := dateService.GetBoxScores(games)
+	if err != nil {
+		log.Fatalf("Error fetching box scores: %v", err)
+	}
+
+	rankings := fantasy.BuildReport(boxScores, profile, *top, *daily, period)
+	printFantasy(rankings)
+
+	if err := saveJSON(rankings, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateFantasyReport(rankings, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func runMatchup(args []string) {
+	fs := newFlagSet("matchup", "-roster rosters.json [-date 2026-01-14] [-profile 9cat] [-config scoring.json]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	rosterFile := fs.String("roster", "", "Fantasy rosters JSON file (required)")
+	date := fs.String("date", "", "Any day of the fantasy week, Monday to Sunday, in YYYY-MM-DD format (default: today)")
+	configFile := fs.String("config", "", "Scoring profiles JSON file (default: built-in standard, espn and 9cat)")
+	profileName := fs.String("profile", fantasy.DefaultProfile, "Scoring profile to score with")
+	outputFile := fs.String("output", "matchup.json", "Output JSON file path")
+	excelFile := fs.String("excel", "matchup.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *rosterFile == "" {
+		fs.Usage()
+		log.Fatalf("Error: -roster is required")
+	}
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *date == "" {
+		*date = time.Now().Format("2006-01-02")
+	}
+	day, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		log.Fatalf("Error: invalid date format '%s': use YYYY-MM-DD format", *date)
+	}
+	profile := loadProfile(*configFile, *profileName)
+
+	rosters, err := fantasy.LoadRosterFile(*rosterFile)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	matchups, err := rosters.Resolve(players.Directory(league))
+	if err != nil {
+		log.Fatalf("Error reading rosters: %v", err)
+	}
+
+	// Averages for projections come from the whole season up to the end of the week
+	weekStart, weekEnd := fantasy.Week(day)
+	seasonStart, _, err := league.SeasonDates(league.SeasonForDate(day))
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if weekStart.Before(seasonStart) {
+		seasonStart = weekStart
+	}
+	start, end := weekStart.Format("2006-01-02"), weekEnd.Format("2006-01-02")
+
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	fmt.Printf("Fetching %s games for the week of %s...\n", league.Name, start)
+	schedule, err := dateService.GetScheduleBetween(seasonStart.Format("2006-01-02"), end)
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", league.Name, err)
+	}
+	boxScores, err := dateService.GetBoxScores(schedule)
+	if err != nil {
+		log.Fatalf("Error fetching box scores: %v", err)
+	}
+
+	week := fantasy.BuildWeek(profile, matchups, start, end, boxScores, schedule)
+	printMatchups(week)
+
+	if err := saveJSON(week, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateMatchupReport(week, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// loadProfile picks a scoring profile from the config file, or from the
+// built-in profiles when no file is given
+func loadProfile(configFile, name string) fantasy.Profile {
+	config := fantasy.DefaultConfig()
+	if configFile != "" {
+		var err error
+		if config, err = fantasy.LoadConfig(configFile); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+	}
+	profile, err := config.Profile(name)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	return profile
+}
+
+func printFantasy(rankings *fantasy.Report) {
+	profile := rankings.Profile
+	fmt.Printf("\nFantasy Rankings (%s scoring, %s)\n", profile.Name, rankings.Period)
+	if len(rankings.Rankings) == 0 {
+		fmt.Println("  No players found")
+	}
+	for _, ranking := range rankings.Rankings {
+		fmt.Printf("  %2d. %-26s %-4s %3d GP  %s\n", ranking.Rank, ranking.Name, ranking.Team, ranking.Games, fantasyValue(profile, ranking))
+	}
+
+	for _, day := range rankings.Daily {
+		fmt.Printf("\n  %s\n", day.Date)
+		for _, ranking := range day.Rankings {
+			value := fmt.Sprintf("%.1f FP", ranking.Value)
+			if !profile.IsPoints() {
+				value = fmt.Sprintf("%+.2f Z", ranking.Value)
+			}
+			fmt.Printf("    %-26s %-4s %-34s %s\n", ranking.Name, ranking.Team, ranking.Line, value)
+		}
+	}
+	fmt.Println()
+}
+
+// fantasyValue formats a period ranking's fantasy points, or its z-score
+// total with the best and worst categories
+func fantasyValue(profile fantasy.Profile, ranking fantasy.Ranking) string {
+	if profile.IsPoints() {
+		return fmt.Sprintf("%7.1f FP  %5.1f FP/G", ranking.Value, ranking.PerGame)
+	}
+	best, worst := profile.Categories[0], profile.Categories[0]
+	for _, key := range profile.Categories {
+		if ranking.Categories[key] > ranking.Categories[best] {
+			best = key
+		}
+		if ranking.Categories[key] < ranking.Categories[worst] {
+			worst = key
+		}
+	}
+	return fmt.Sprintf("%+6.2f Z  (best %s %+.2f, worst %s %+.2f)", ranking.Value,
+		fantasy.CategoryName(best), ranking.Categories[best], fantasy.CategoryName(worst), ranking.Categories[worst])
+}
+
+func printMatchups(week *fantasy.WeekReport) {
+	fmt.Printf("\nFantasy Matchups, %s to %s (%s scoring, %d games left this week)\n",
+		week.Start, week.End, week.Profile.Name, week.Remaining)
+	for _, matchup := range week.Matchups {
+		var names []string
+		for _, team := range matchup.Teams {
+			names = append(names, team.Name)
+		}
+		fmt.Printf("\n  %s\n", strings.Join(names, " vs "))
+
+		for _, team := range matchup.Teams {
+			if week.Profile.IsPoints() {
+				fmt.Printf("    %-24s %7.1f FP (projected %.1f)\n", team.Name, team.Points, team.ProjectedPoints)
+				for _, player := range team.Players {
+					fmt.Printf("      %-26s %-4s %d played, %d left  %6.1f FP (projected %.1f)\n", player.Name,
+						player.Team, player.Played, player.Remaining, player.Points, player.ProjectedPoints)
+				}
+				continue
+			}
+			var totals []string
+			for _, value := range team.Categories {
+				totals = append(totals, fmt.Sprintf("%s %s", value.Name, formatCategory(value)))
+			}
+			fmt.Printf("    %-24s %d-%d categories (projected %d)  %s\n", team.Name, team.CategoryWins,
+				len(week.Profile.Categories)-team.CategoryWins-matchup.Ties, team.ProjectedWins, strings.Join(totals, ", "))
+		}
+
+		if len(matchup.Teams) == 2 {
+			fmt.Printf("    Leader: %s; projected winner: %s\n", orLevel(matchup.Leader), orLevel(matchup.ProjectedWinner))
+		}
+	}
+	fmt.Println()
+}
+
+// formatCategory formats a category total so far, with percentages as .456
+func formatCategory(value fantasy.CategoryValue) string {
+	if value.Percentage {
+		return strings.TrimPrefix(fmt.Sprintf("%.3f", value.Actual), "0")
+	}
+	return fmt.Sprintf("%.0f", value.Actual)
+}
+
+// orLevel returns name, or "level" when no team is ahead
+func orLevel(name string) string {
+	if name == "" {
+		return "level"
+	}
+	return name
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+func runFatigue(args []string) {
+	fs := newFlagSet("fatigue", "[-season 2023-24 | -start-date ... -end-date ...] [-team LAL,BOS]")
+	query := addQueryFlags(fs)
+	teams := fs.String("team", "", "Only list these teams (e.g., LAL,BOS)")
+	outputFile := fs.String("output", "fatigue.json", "Output JSON file path")
+	excelFile := fs.String("excel", "fatigue.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+	if len(games) == 0 {
+		log.Fatalf("Error: no %s games found for %s", dateService.League().Name, period)
+	}
+
+	first := games[0].Date
+	for _, game := range games {
+		if game.Date < first {
+			first = game.Date
+		}
+	}
+	if err := addFatigue(dateService.League(), first, games); err != nil {
+		log.Fatalf("Error working out fatigue: %v", err)
+	}
+
+	fatigue := stats.BuildFatigueReport(games, period)
+	if codes := nba.ParseList(*teams); len(codes) > 0 {
+		fatigue.Teams = filterFatigueTeams(fatigue.Teams, codes)
+	}
+	printFatigue(fatigue)
+
+	if err := saveJSON(fatigue, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateFatigueReport(fatigue, games, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// addFatigue works out the rest and travel of every game, using the days
+// before startDate so that the first games know where teams came from
+func addFatigue(league nba.League, startDate string, games []nba.Game) error {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return fmt.Errorf("invalid date format '%s': use YYYY-MM-DD format: %w", startDate, err)
+	}
+
+	// Rest depends on every game a team played, not just the filtered ones
+	schedule := nba.NewDateService(nba.NewLeagueClient(league))
+	history, err := schedule.GetScheduleBetween(
+		start.AddDate(0, 0, -stats.FatigueLookbackDays).Format("2006-01-02"),
+		start.AddDate(0, 0, -1).Format("2006-01-02"))
+	if err != nil {
+		return err
+	}
+
+	stats.AddFatigue(league, games, history)
+	return nil
+}
+
+// filterFatigueTeams keeps the splits of the given teams
+func filterFatigueTeams(teams []stats.TeamFatigueSplits, codes []string) []stats.TeamFatigueSplits {
+	wanted := make(map[string]bool)
+	for _, code := range codes {
+		wanted[strings.ToUpper(code)] = true
+	}
+	var filtered []stats.TeamFatigueSplits
+	for _, team := range teams {
+		if wanted[team.Team] {
+			filtered = append(filtered, team)
+		}
+	}
+	return filtered
+}
+
+func printFatigue(fatigue *stats.FatigueReport) {
+	fmt.Printf("\nPerformance by Fatigue (%s)\n", fatigue.Period)
+	fmt.Printf("  %-22s %6s %8s %6s %7s\n", "Condition", "Games", "Record", "Win%", "Margin")
+	for _, split := range fatigue.League {
+		fmt.Printf("  %-22s %6d %8s %5.1f%% %+7.1f\n", split.Condition, split.Games,
+			fmt.Sprintf("%d-%d", split.Wins, split.Losses), split.WinPct*100, split.AvgMargin)
+	}
+
+	fmt.Println("\nTeams (record on back-to-backs, after long trips, with a rest advantage):")
+	for _, team := range fatigue.Teams {
+		fmt.Printf("  %-5s %4d games %8.0f mi", team.Team, team.Games, team.TravelMiles)
+		for _, split := range team.Splits {
+			switch split.Condition {
+			case stats.ConditionBackToBack, stats.ConditionLongTrip, stats.ConditionRestAdvantage:
+				fmt.Printf("  %s %d-%d", split.Condition, split.Wins, split.Losses)
+			}
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runFeats(args []string) {
+	fs := newFlagSet("feats", "[-season 2023-24 | -start-date ... -end-date ...] [-top 10]")
+	query := addQueryFlags(fs)
+	top := fs.Int("top", 10, "Players listed with the most feats")
+	outputFile := fs.String("output", "feats.json", "Output JSON file path")
+	excelFile := fs.String("excel", "feats.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	// Season counts include the season's games before the period
+	var history []nba.Game
+	if len(games) > 0 {
+		var err error
+		history, err = seasonHistory(dateService, games[0].Date)
+		if err != nil {
+			log.Fatalf("Error fetching %s games: %v", dateService.League().Name, err)
+		}
+	}
+	historyBoxScores, err := dateService.GetBoxScores(history)
+	if err != nil {
+		log.Fatalf("Error fetching box scores: %v", err)
+	}
+	boxScores, err := dateService.GetBoxScores(games)
+	if err != nil {
+		log.Fatalf("Error fetching box scores: %v", err)
+	}
+
+	feats := players.BuildFeatsReport(historyBoxScores, boxScores, period)
+	printFeats(feats, *top)
+
+	if err := saveJSON(feats, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateFeatsReport(feats, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// addFeats tags the final games with their feats. Season counts run from the
+// start of the season of startDate, so the games before it are fetched too.
+func addFeats(league nba.League, startDate string, games []nba.Game) error {
+	// Counts include every game of the season, not just the filtered ones
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	history, err := seasonHistory(dateService, startDate)
+	if err != nil {
+		return err
+	}
+	return tagFeats(dateService, history, games)
+}
+
+// seasonHistory fetches the games of startDate's season played before it
+func seasonHistory(dateService *nba.DateService, startDate string) ([]nba.Game, error) {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format '%s': use YYYY-MM-DD format: %w", startDate, err)
+	}
+	league := dateService.League()
+	seasonStart, _, err := league.SeasonDates(league.SeasonForDate(start))
+	if err != nil {
+		return nil, err
+	}
+	if !seasonStart.Before(start) {
+		return nil, nil
+	}
+	return dateService.GetGamesBetween(seasonStart.Format("2006-01-02"), start.AddDate(0, 0, -1).Format("2006-01-02"))
+}
+
+// tagFeats tags games with their feats, counting each player's feats over
+// history, the season's earlier games, and games
+func tagFeats(dateService *nba.DateService, history, games []nba.Game) error {
+	all := append(append([]nba.Game(nil), history...), games...)
+	boxScores, err := dateService.GetBoxScores(all)
+	if err != nil {
+		return err
+	}
+	players.AddFeats(games, players.DetectFeats(boxScores))
+	return nil
+}
+
+func printFeats(feats *players.FeatsReport, top int) {
+	fmt.Printf("\nFeats (%s)\n", feats.Period)
+	for _, count := range feats.Counts {
+		if count.Count > 0 {
+			fmt.Printf("  %-24s %4d\n", count.Label, count.Count)
+		}
+	}
+	if len(feats.Feats) == 0 {
+		fmt.Println("  No feats")
+		fmt.Println()
+		return
+	}
+
+	fmt.Println("\n  Most Feats")
+	for i, player := range feats.Players {
+		if i == top {
+			break
+		}
+		fmt.Printf("    %2d. %-26s %-4s %3d", i+1, player.Name, player.Team, player.Total)
+		for _, count := range feats.Counts {
+			if n := player.Counts[count.Tag]; n > 0 {
+				fmt.Printf("  %s %d", count.Label, n)
+			}
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+}
+
+// printNotablePerformances lists feats one per line
+func printNotablePerformances(feats []nba.Feat) {
+	if len(feats) == 0 {
+		return
+	}
+	fmt.Println("Notable Performances:")
+	for _, feat := range feats {
+		fmt.Printf("  %s [%s] %s\n", feat.Date, feat.Tag, feat.Description)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runGameLog(args []string) {
+	fs := newFlagSet("gamelog", "-team LAL [-last 10] [-season 2023-24 | -start-date ... -end-date ...]")
+	query := addQueryFlags(fs)
+	team := fs.String("team", "", "Team code (e.g., LAL)")
+	last := fs.Int("last", 0, "Only show the last N games (default: whole period)")
+	outputFile := fs.String("output", "gamelog.json", "Output JSON file path")
+	excelFile := fs.String("excel", "gamelog.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *team == "" {
+		fs.Usage()
+		log.Fatalf("Error: -team is required")
+	}
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s game log for %s...\n", dateService.League().Name, strings.ToUpper(*team))
+
+	gameLog, err := dateService.GetTeamGameLog(nba.GameLogRequest{
+		Team:      *team,
+		Season:    *query.season,
+		StartDate: *query.startDate,
+		EndDate:   *query.endDate,
+		LastN:     *last,
+	})
+	if err != nil {
+		log.Fatalf("Error building game log: %v", err)
+	}
+
+	printGameLog(gameLog)
+
+	if err := saveJSON(gameLog, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateGameLogReport(gameLog, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printGameLog(gameLog *nba.TeamGameLog) {
+	fmt.Printf("\n%s Game Log (%s)\n", gameLog.Team, gameLog.Period)
+	fmt.Printf("  Record: %s  Streak: %s\n", gameLog.Record, gameLog.Streak)
+	fmt.Printf("  Form:   %s\n\n", strings.Join(strings.Split(gameLog.Form, ""), " "))
+
+	for _, entry := range gameLog.Games {
+		fmt.Printf("  %s  %-7s %s %3d-%-3d %+4d  %-7s %s\n", entry.Date, entry.Matchup(), entry.Result,
+			entry.TeamScore, entry.OpponentScore, entry.Margin, entry.Record, entry.Streak)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runHeadToHead(args []string) {
+	fs := newFlagSet("h2h", "-teams LAL,BOS [-season 2023-24 | -start-date ... -end-date ...]")
+	query := addQueryFlags(fs)
+	teams := fs.String("teams", "", "The two teams to compare (e.g., LAL,BOS)")
+	outputFile := fs.String("output", "h2h.json", "Output JSON file path")
+	excelFile := fs.String("excel", "h2h.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	codes := nba.ParseList(*teams)
+	if len(codes) != 2 {
+		fs.Usage()
+		log.Fatalf("Error: -teams needs exactly two team codes")
+	}
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s meetings between %s and %s...\n", dateService.League().Name, codes[0], codes[1])
+
+	h2h, err := dateService.GetHeadToHead(nba.HeadToHeadRequest{
+		TeamA:     codes[0],
+		TeamB:     codes[1],
+		Season:    *query.season,
+		StartDate: *query.startDate,
+		EndDate:   *query.endDate,
+	})
+	if err != nil {
+		log.Fatalf("Error building head-to-head: %v", err)
+	}
+
+	printHeadToHead(h2h)
+
+	if err := saveJSON(h2h, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateHeadToHeadReport(h2h, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printHeadToHead(h2h *nba.HeadToHead) {
+	fmt.Printf("\n%s vs %s (%s)\n", h2h.TeamA, h2h.TeamB, h2h.Period)
+	fmt.Printf("  Series: %s\n", h2h.Record.Summary)
+	if len(h2h.Meetings) == 0 {
+		fmt.Println()
+		return
+	}
+
+	split := h2h.HomeAway
+	fmt.Printf("  Average margin (%s): %+.1f\n", h2h.TeamA, h2h.AverageMargin)
+	fmt.Printf("  %s at home: %d-%d, on road: %d-%d\n", h2h.TeamA,
+		split.TeamAHomeWins, split.TeamAHomeLosses, split.TeamAAwayWins, split.TeamAAwayLosses)
+	fmt.Printf("  Last meeting: %s\n", h2h.LastMeetingSummary)
+
+	fmt.Println("\nMeetings:")
+	for _, meeting := range h2h.Meetings {
+		fmt.Printf("  %s  %s %3d @ %s %3d  (%s by %d)\n", meeting.Date,
+			meeting.AwayTeam.Code, meeting.AwayTeam.Score, meeting.HomeTeam.Code, meeting.HomeTeam.Score,
+			meeting.Winner, meeting.Margin)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/players"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runLeaders(args []string) {
+	defaults := players.DefaultQualifiers()
+
+	fs := newFlagSet("leaders", "[-season 2023-24 | -start-date ... -end-date ...] [options]")
+	query := addQueryFlags(fs)
+	top := fs.Int("top", players.DefaultTopPlayers, "Players on each leaderboard")
+	daily := fs.Int("daily", players.DefaultDailyTopLines, "Top performers listed for each day")
+	totals := fs.Bool("totals", false, "Rank counting stats by totals instead of per game")
+	minGames := fs.Float64("min-games", defaults.MinGamesPct, "Share of the most games played needed to qualify")
+	minFGA := fs.Float64("min-fga", defaults.MinFieldGoalAttempts, "Field goal attempts per game needed for field goal %")
+	min3PA := fs.Float64("min-3pa", defaults.MinThreePointAttempts, "Three-point attempts per game needed for three-point %")
+	minFTA := fs.Float64("min-fta", defaults.MinFreeThrowAttempts, "Free throw attempts per game needed for free throw %")
+	outputFile := fs.String("output", "leaders.json", "Output JSON file path")
+	excelFile := fs.String("excel", "leaders.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *top <= 0 {
+		log.Fatalf("Error: -top must be positive")
+	}
+	qualifiers := players.Qualifiers{
+		MinGamesPct:           *minGames,
+		MinFieldGoalAttempts:  *minFGA,
+		MinThreePointAttempts: *min3PA,
+		MinFreeThrowAttempts:  *minFTA,
+	}
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	boxScores, err := dateService.GetBoxScores(games)
+	if err != nil {
+		log.Fatalf("Error fetching box scores: %v", err)
+	}
+
+	leaders := players.BuildLeadersReport(boxScores, qualifiers, *top, *daily, *totals, period)
+	printLeaders(leaders)
+
+	if err := saveJSON(leaders, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateLeadersReport(leaders, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printLeaders(leaders *players.LeadersReport) {
+	fmt.Printf("\nLeague Leaders (%s, %d players, %d+ games to qualify)\n", leaders.Period, len(leaders.Players), leaders.MinGames)
+	for _, board := range leaders.Leaderboards {
+		label := "per game"
+		switch {
+		case board.Percentage:
+			label = "percentage"
+		case board.Totals:
+			label = "total"
+		}
+		fmt.Printf("\n  %s (%s)\n", board.Category, label)
+		if len(board.Leaders) == 0 {
+			fmt.Println("    No qualified players")
+		}
+		for _, leader := range board.Leaders {
+			value := fmt.Sprintf("%.1f", leader.Value)
+			switch {
+			case board.Percentage:
+				value = fmt.Sprintf("%.1f%%", leader.Value*100)
+			case board.Totals:
+				value = fmt.Sprintf("%.0f", leader.Value)
+			}
+			fmt.Printf("    %2d. %-26s %-4s %3d GP %7s\n", leader.Rank, leader.Name, leader.Team, leader.Games, value)
+		}
+	}
+
+	if len(leaders.TopPerformers) > 0 {
+		fmt.Println("\n  Top Performers")
+		for _, performer := range leaders.TopPerformers {
+			matchup := "@ " + performer.Opponent
+			if performer.Home {
+				matchup = "vs " + performer.Opponent
+			}
+			fmt.Printf("    %s %-26s %-4s %-7s %-34s GmSc %.1f\n", performer.Date, performer.Name,
+				performer.Team, matchup, performer.Line, performer.GameScore)
+		}
+	}
+	fmt.Println()
+}
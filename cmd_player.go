@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runPlayer(args []string) {
+	fs := newFlagSet("player", "-name \"Nikola Jokic\" [-team DEN] [-last 10] [-season 2023-24 | -start-date ... -end-date ...]")
+	query := addQueryFlags(fs)
+	name := fs.String("name", "", "Player name to search for; accents and small typos are ignored")
+	team := fs.String("team", "", "Only search this team's roster (e.g., DEN)")
+	last := fs.Int("last", 10, "Only show the last N games (0 for the whole period)")
+	search := fs.Bool("search", false, "List the matching players without fetching games")
+	outputFile := fs.String("output", "player.json", "Output JSON file path")
+	csvFile := fs.String("csv", "player.csv", "Output CSV file path")
+	excelFile := fs.String("excel", "player.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if strings.TrimSpace(*name) == "" {
+		fs.Usage()
+		log.Fatalf("Error: -name is required")
+	}
+	if *last < 0 {
+		log.Fatalf("Error: -last cannot be negative")
+	}
+
+	dateService := query.dateService()
+	league := dateService.League()
+	directory := players.Directory(league)
+	if *team != "" {
+		if _, ok := nba.LookupTeam(league, *team); !ok {
+			log.Fatalf("Error: unknown %s team '%s'", league.Name, *team)
+		}
+		directory = nba.Roster(league, *team)
+	}
+
+	matches := players.Search(directory, *name)
+	if len(matches) == 0 {
+		log.Fatalf("Error: no %s player matches '%s'", league.Name, *name)
+	}
+	if *search {
+		printMatches(matches)
+		return
+	}
+	if len(matches) > 1 && matches[0].Score == matches[1].Score {
+		printMatches(matches)
+		log.Fatalf("Error: '%s' matches more than one player; use a fuller name or -team", *name)
+	}
+	player := matches[0].Player
+
+	fmt.Printf("Fetching %s games for %s (%s)...\n", league.Name, player.Name, player.Team)
+	games, period := query.games(dateService)
+	var teamGames []nba.Game
+	for _, game := range games {
+		if game.HasTeam(player.Team) {
+			teamGames = append(teamGames, game)
+		}
+	}
+
+	boxScores, err := dateService.GetBoxScores(teamGames)
+	if err != nil {
+		log.Fatalf("Error fetching box scores: %v", err)
+	}
+
+	gameLog := players.BuildPlayerGameLog(player, boxScores, *last, period)
+	printPlayerGameLog(gameLog)
+
+	if err := saveJSON(gameLog, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	if err := report.WritePlayerGameLogCSV(gameLog, *csvFile); err != nil {
+		log.Fatalf("Error saving CSV: %v", err)
+	}
+	fmt.Printf("CSV results saved to: %s\n", *csvFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GeneratePlayerGameLogReport(gameLog, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printMatches(matches []players.Match) {
+	fmt.Println("\nMatching players:")
+	for _, match := range matches {
+		fmt.Printf("  %-28s %-4s %s  (%.0f%%)\n", match.Player.Name, match.Player.Team, match.Player.ID, match.Score*100)
+	}
+	fmt.Println()
+}
+
+func printPlayerGameLog(gameLog *players.PlayerGameLog) {
+	fmt.Printf("\n%s (%s) Game Log (%s)\n", gameLog.Player.Name, gameLog.Player.Team, gameLog.Period)
+	if len(gameLog.Games) == 0 {
+		fmt.Println("  No games played")
+		fmt.Println()
+		return
+	}
+
+	averages := gameLog.Averages
+	fmt.Printf("  %d games, team %s, %+d  |  %.1f MIN  %.1f PTS  %.1f REB  %.1f AST  %.1f%% FG\n\n",
+		len(gameLog.Games), gameLog.Record, gameLog.PlusMinus, averages.Minutes, averages.Points,
+		averages.Rebounds, averages.Assists, averages.FieldGoalPct*100)
+
+	for _, game := range gameLog.Games {
+		fmt.Printf("  %s  %-7s %-9s %2d MIN %+4d  %s\n", game.Date, game.Matchup(), game.Score(),
+			game.Minutes, game.PlusMinus, game.Line)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/pool"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runPool(args []string) {
+	fs := newFlagSet("pool", "-picks picks.csv [-sheet Picks]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	picksFile := fs.String("picks", "", "Picks JSON, CSV or Excel file (required)")
+	sheet := fs.String("sheet", "", "Sheet of an Excel picks file (default: the first)")
+	outputFile := fs.String("output", "pool.json", "Output JSON file path")
+	excelFile := fs.String("excel", "pool.xlsx", "Output Excel file path")
+	markdownFile := fs.String("markdown", "pool.md", "Output Markdown file path")
+	fs.Parse(args)
+
+	if *picksFile == "" {
+		fs.Usage()
+		log.Fatalf("Error: -picks is required")
+	}
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	picks, err := pool.Load(*picksFile, *sheet)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if len(picks) == 0 {
+		fmt.Println("No picks to score.")
+		return
+	}
+
+	first, last := pool.DateRange(picks)
+	fmt.Printf("Fetching %s games from %s to %s...\n", league.Name, first, last)
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	games, err := dateService.GetScheduleBetween(first, last)
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", league.Name, err)
+	}
+
+	results, err := pool.Score(picks, games)
+	if err != nil {
+		log.Fatalf("Error scoring picks: %v", err)
+	}
+	printPool(results)
+
+	if err := saveJSON(results, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	markdown, err := pool.Markdown(results)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if err := os.WriteFile(*markdownFile, []byte(markdown), 0644); err != nil {
+		log.Fatalf("Error saving Markdown: %v", err)
+	}
+	fmt.Printf("Markdown leaderboards saved to: %s\n", *markdownFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GeneratePoolReport(results, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printPool(results *pool.Report) {
+	fmt.Printf("\nPick'em Pool (%s)\n", results.Period)
+	printLeaderboard(results.Season)
+	if len(results.Weeks) > 0 {
+		week := results.Weeks[len(results.Weeks)-1]
+		status := "in progress"
+		if week.Complete {
+			status = "complete"
+		}
+		fmt.Printf("\nWeek of %s (%s)\n", week.Start, status)
+		printLeaderboard(week.Standings)
+	}
+	fmt.Println()
+}
+
+func printLeaderboard(standings []pool.Standing) {
+	fmt.Printf("  %4s %-20s %6s %7s %5s %7s %6s %5s %5s\n",
+		"Rank", "Participant", "Points", "Correct", "Wrong", "Pending", "Pct", "Lost", "Weeks")
+	for _, standing := range standings {
+		fmt.Printf("  %4d %-20s %6d %7d %5d %7d %6.3f %5d %5d\n",
+			standing.Rank, standing.Participant, standing.Points, standing.Correct, standing.Wrong,
+			standing.Pending, standing.Pct, standing.PointsLost, standing.WeeksWon)
+	}
+}
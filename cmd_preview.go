@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/preview"
+	"github.com/jeremielumandong/nba-result/internal/report"
+)
+
+func runPreview(args []string) {
+	fs := newFlagSet("preview", "[-date 2026-12-25] [-team LAL,BOS]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	date := fs.String("date", "", "Date in YYYY-MM-DD format (default: today)")
+	teams := fs.String("team", "", "Only preview games involving these teams (e.g., LAL,BOS)")
+	markdownFile := fs.String("output", "previews.md", "Output Markdown file path")
+	htmlFile := fs.String("html", "previews.html", "Output HTML file path")
+	excelFile := fs.String("excel", "previews.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *date == "" {
+		*date = time.Now().Format("2006-01-02")
+	}
+	day, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		log.Fatalf("Error: invalid date format '%s': use YYYY-MM-DD format", *date)
+	}
+
+	filter := &nba.GameFilter{League: league, Teams: nba.ParseList(*teams), Statuses: []string{"Scheduled"}}
+	if err := filter.Validate(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	dateService.SetFilter(filter)
+
+	fmt.Printf("Fetching %s schedule for %s...\n", league.Name, *date)
+	result, err := dateService.GetScheduleByDate(*date)
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", league.Name, err)
+	}
+	if len(result.Games) == 0 {
+		fmt.Println("No scheduled games to preview.")
+		return
+	}
+
+	// Records, form and rest come from the whole season so far, not just the filtered games
+	var history []nba.Game
+	seasonStart, _, err := league.SeasonDates(league.SeasonForDate(day))
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if seasonStart.Before(day) {
+		schedule := nba.NewDateService(nba.NewLeagueClient(league))
+		history, err = schedule.GetScheduleBetween(seasonStart.Format("2006-01-02"), day.AddDate(0, 0, -1).Format("2006-01-02"))
+		if err != nil {
+			log.Fatalf("Error fetching %s games: %v", league.Name, err)
+		}
+	}
+
+	if err := predictGames(league, *date, result.Games); err != nil {
+		log.Fatalf("Error predicting games: %v", err)
+	}
+
+	previews := preview.BuildPreviews(league, result.Games, history)
+	printPreviews(previews)
+
+	markdown, err := preview.Markdown(previews)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if err := os.WriteFile(*markdownFile, []byte(markdown), 0644); err != nil {
+		log.Fatalf("Error saving Markdown: %v", err)
+	}
+	fmt.Printf("Markdown previews saved to: %s\n", *markdownFile)
+
+	html, err := preview.HTML(previews)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if err := os.WriteFile(*htmlFile, []byte(html), 0644); err != nil {
+		log.Fatalf("Error saving HTML: %v", err)
+	}
+	fmt.Printf("HTML previews saved to: %s\n", *htmlFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GeneratePreviewsReport(previews, *date, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printPreviews(previews []preview.Preview) {
+	for _, p := range previews {
+		fmt.Printf("\n%s  %s %s\n", p.Matchup(), p.Date, p.Time)
+		fmt.Printf("  %-8s %-14s %-14s\n", "", p.Away.Code, p.Home.Code)
+		fmt.Printf("  %-8s %-14s %-14s\n", "Record", p.Away.Record, p.Home.Record)
+		fmt.Printf("  %-8s %-14s %-14s\n", "Venue", p.Away.Venue+" road", p.Home.Venue+" home")
+		fmt.Printf("  %-8s %-14s %-14s\n", "Last 10", p.Away.LastTen, p.Home.LastTen)
+		fmt.Printf("  %-8s %-14s %-14s\n", "Streak", p.Away.Streak, p.Home.Streak)
+		fmt.Printf("  %-8s %-14s %-14s\n", "Rest", p.Away.Rest(), p.Home.Rest())
+		fmt.Printf("  Series: %s\n", p.Series)
+		if p.Prediction != nil {
+			fmt.Printf("  Prediction: %s %.0f%% (expected margin %+.1f)\n",
+				p.PredictedWinner, winnerProbability(p.Prediction)*100, p.Prediction.ExpectedMargin)
+		}
+	}
+	fmt.Println()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+func runPythag(args []string) {
+	defaults := stats.DefaultPythagoreanConfig()
+
+	fs := newFlagSet("pythag", "[-season 2023-24 | -start-date ... -end-date ...] [options]")
+	query := addQueryFlags(fs)
+	exponent := fs.Float64("exponent", defaults.Exponent, "Pythagorean exponent")
+	threshold := fs.Float64("threshold", defaults.LuckThreshold, "Wins above or below expectation flagged as over- or under-performing")
+	closeMargin := fs.Int("close-margin", defaults.CloseGameMargin, "Largest final margin of a close game")
+	outputFile := fs.String("output", "pythag.json", "Output JSON file path")
+	excelFile := fs.String("excel", "pythag.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	if *exponent <= 0 {
+		log.Fatalf("Error: -exponent must be positive")
+	}
+	config := stats.PythagoreanConfig{Exponent: *exponent, LuckThreshold: *threshold, CloseGameMargin: *closeMargin}
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	pythag := stats.BuildPythagoreanReport(games, config, period)
+	printPythag(pythag)
+
+	if err := saveJSON(pythag, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GeneratePythagoreanReport(pythag, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printPythag(pythag *stats.PythagoreanReport) {
+	fmt.Printf("\nPythagorean Expectation (%s, exponent %.2f)\n", pythag.Period, pythag.Config.Exponent)
+	fmt.Printf("  %-4s %-7s %-7s %6s %6s %s\n", "Team", "Record", "Exp", "Luck", "Margin", "Close")
+	for _, team := range pythag.Teams {
+		expected := fmt.Sprintf("%.1f-%.1f", team.ExpectedWins, float64(team.Games)-team.ExpectedWins)
+		fmt.Printf("  %-4s %-7s %-7s %+6.1f %+6.1f %-7s %s\n", team.Team,
+			fmt.Sprintf("%d-%d", team.Wins, team.Losses), expected, team.Luck, team.MarginPerGame,
+			fmt.Sprintf("%d-%d", team.CloseWins, team.CloseLosses), team.Verdict)
+	}
+	fmt.Println()
+}
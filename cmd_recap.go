@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/recap"
+)
+
+func runRecap(args []string) {
+	fs := newFlagSet("recap", "[-date 2024-01-15] [-format markdown|text] [-template file] [-digest-template file]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	date := fs.String("date", "", "Date in YYYY-MM-DD format (default: yesterday)")
+	format := fs.String("format", recap.FormatMarkdown, "Output format: markdown or text")
+	gameTemplate := fs.String("template", "", "File with a text/template for each game's recap sentence")
+	digestTemplate := fs.String("digest-template", "", "File with a text/template for the daily digest")
+	noSpoilers := fs.Bool("no-spoilers", false, "Leave out results, records and streaks; list status and watchability instead")
+	outputFile := fs.String("output", "", "Output file path (default: recap.md, or recap.txt for text)")
+	fs.Parse(args)
+
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *date == "" {
+		*date = time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	day, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		log.Fatalf("Error: invalid date format '%s': use YYYY-MM-DD format", *date)
+	}
+	if *outputFile == "" {
+		*outputFile = "recap.md"
+		if *format == recap.FormatText {
+			*outputFile = "recap.txt"
+		}
+	}
+
+	templates := recap.Templates{
+		Game:   readTemplate(*gameTemplate),
+		Digest: readTemplate(*digestTemplate),
+	}
+	renderer, err := recap.NewRenderer(*format, templates, *noSpoilers)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	// Records and streaks need the season so far, or the one just finished in the off-season
+	seasonStart := league.LatestSeasonStart(day)
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	fmt.Printf("Fetching %s games for %s...\n", league.Name, *date)
+	games, err := dateService.GetGamesBetween(seasonStart.Format("2006-01-02"), *date)
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", league.Name, err)
+	}
+
+	// Only the day's games are rated; games are returned in date order
+	first := len(games)
+	for first > 0 && games[first-1].Date == *date {
+		first--
+	}
+	addWatchability(dateService, games[first:])
+	if !*noSpoilers {
+		if err := tagFeats(dateService, games[:first], games[first:]); err != nil {
+			log.Fatalf("Error detecting feats: %v", err)
+		}
+	}
+
+	digest := recap.BuildDigest(league, *date, games)
+	if *noSpoilers {
+		digest = digest.HideSpoilers()
+	}
+	text, err := renderer.Render(digest)
+	if err != nil {
+		log.Fatalf("Error rendering recap: %v", err)
+	}
+
+	fmt.Println()
+	fmt.Println(text)
+
+	if err := os.WriteFile(*outputFile, []byte(text), 0644); err != nil {
+		log.Fatalf("Error saving recap: %v", err)
+	}
+	fmt.Printf("Recap saved to: %s\n", *outputFile)
+}
+
+// readTemplate returns the contents of a template file, or "" for the default
+func readTemplate(path string) string {
+	if path == "" {
+		return ""
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Error reading template: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/ratings"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/sim"
+)
+
+func runSimulate(args []string) {
+	defaults := sim.DefaultConfig()
+
+	fs := newFlagSet("simulate", "[-season 2023-24] [options]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	season := fs.String("season", "", "Season to simulate, e.g. 2023-24 (default: current season)")
+	iterations := fs.Int("iterations", defaults.Iterations, "Number of seasons to simulate")
+	seed := fs.Int64("seed", defaults.Seed, "Random seed; the same seed gives the same odds")
+	workers := fs.Int("workers", runtime.NumCPU(), "Number of parallel workers")
+	modelName := fs.String("model", "elo", "Rating model: elo or record")
+	outputFile := fs.String("output", "simulation.json", "Output JSON file path")
+	excelFile := fs.String("excel", "simulation.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *season == "" {
+		*season = league.SeasonForDate(time.Now())
+	}
+
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	fmt.Printf("Fetching %s %s schedule...\n", league.Name, *season)
+	played, remaining, err := dateService.GetRegularSeason(*season)
+	if err != nil {
+		log.Fatalf("Error fetching %s schedule: %v", league.Name, err)
+	}
+
+	model, err := simulationModel(*modelName, league, played)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	config := sim.Config{Iterations: *iterations, Workers: *workers, Seed: *seed}
+	simulator, err := sim.NewSimulator(league, played, remaining, model, config)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	fmt.Printf("Simulating %d seasons (%d games played, %d remaining)...\n", *iterations, len(played), len(remaining))
+	result := simulator.Run()
+	result.Season = *season
+	result.Model = *modelName
+
+	printSimulation(result)
+
+	if err := saveJSON(result, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateSimulationReport(result, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// simulationModel builds the named rating model from the games played so far
+func simulationModel(name string, league nba.League, played []nba.Game) (sim.Model, error) {
+	switch name {
+	case "elo":
+		engine := ratings.NewEloEngine(league, ratings.DefaultEloConfig())
+		engine.Process(played)
+		return engine, nil
+	case "record":
+		return sim.NewRecordModel(played, sim.DefaultHomeWinRate), nil
+	default:
+		return nil, fmt.Errorf("unknown model '%s': use elo or record", name)
+	}
+}
+
+func printSimulation(result *sim.Result) {
+	fmt.Printf("\nPlayoff Odds (%s, %d simulations, %s model)\n", result.Season, result.Config.Iterations, result.Model)
+	group := ""
+	for _, team := range result.Teams {
+		if team.Group != group {
+			group = team.Group
+			fmt.Printf("\n  %-4s %7s %8s %8s %8s\n", group, "Proj W", "Play-In", "Playoff", "Title")
+		}
+		fmt.Printf("  %-4s %7.1f %7.1f%% %7.1f%% %7.1f%%\n", team.Team, team.ProjectedWins,
+			team.PlayInProbability*100, team.PlayoffProbability*100, team.ChampionProbability*100)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/ratings"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/standings"
+)
+
+func runSOS(args []string) {
+	fs := newFlagSet("sos", "[-season 2023-24] [-team LAL,BOS]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	season := fs.String("season", "", "Season to analyse, e.g. 2023-24 (default: current season)")
+	teams := fs.String("team", "", "Only list these teams (e.g., LAL,BOS)")
+	outputFile := fs.String("output", "sos.json", "Output JSON file path")
+	excelFile := fs.String("excel", "sos.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *season == "" {
+		*season = league.SeasonForDate(time.Now())
+	}
+
+	_, schedule, err := seasonStrength(league, *season)
+	if err != nil {
+		log.Fatalf("Error fetching %s schedule: %v", league.Name, err)
+	}
+	if codes := nba.ParseList(*teams); len(codes) > 0 {
+		schedule.Teams = filterSchedules(schedule.Teams, codes)
+	}
+	printSOS(schedule)
+
+	if err := saveJSON(schedule, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateScheduleReport(schedule, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+// seasonStrength fetches a season's schedule and works out every team's
+// strength of schedule, rating opponents with Elo ratings from the games played
+func seasonStrength(league nba.League, season string) ([]nba.Game, *standings.ScheduleReport, error) {
+	dateService := nba.NewDateService(nba.NewLeagueClient(league))
+	fmt.Printf("Fetching %s %s schedule...\n", league.Name, season)
+	played, remaining, err := dateService.GetRegularSeason(season)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	engine := ratings.NewEloEngine(league, ratings.DefaultEloConfig())
+	engine.Process(played)
+	return played, standings.BuildScheduleReport(league, played, remaining, engine, season), nil
+}
+
+// filterSchedules keeps the schedules of the given teams
+func filterSchedules(teams []standings.TeamSchedule, codes []string) []standings.TeamSchedule {
+	wanted := make(map[string]bool)
+	for _, code := range codes {
+		wanted[strings.ToUpper(code)] = true
+	}
+	var filtered []standings.TeamSchedule
+	for _, team := range teams {
+		if wanted[team.Team] {
+			filtered = append(filtered, team)
+		}
+	}
+	return filtered
+}
+
+func printSOS(schedule *standings.ScheduleReport) {
+	fmt.Printf("\nStrength of Schedule (%s, hardest remaining first)\n", schedule.Season)
+	fmt.Printf("  %-4s %-7s | %5s %5s %5s %4s %6s | %4s %5s %5s %4s %6s %4s %4s %3s\n", "Team", "Record",
+		"OWP", "OOWP", "SOS", "Rank", "OppElo", "Left", "OWP", "SOS", "Rank", "OppElo", "Home", "Road", "B2B")
+	for _, team := range schedule.Teams {
+		fmt.Printf("  %-4s %-7s | %5.3f %5.3f %5.3f %4d %6.0f | %4d %5.3f %5.3f %4d %6.0f %4d %4d %3d\n", team.Team,
+			fmt.Sprintf("%d-%d", team.Wins, team.Losses),
+			team.Played.OpponentWinPct, team.Played.OpponentsOpponentWinPct, team.Played.SOS, team.PlayedRank,
+			team.Played.OpponentRating, team.Remaining.Games, team.Remaining.OpponentWinPct, team.Remaining.SOS,
+			team.RemainingRank, team.Remaining.OpponentRating, team.RemainingHome, team.RemainingRoad, team.BackToBacksLeft)
+	}
+	fmt.Println()
+}
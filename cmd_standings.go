@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/standings"
+)
+
+func runStandings(args []string) {
+	fs := newFlagSet("standings", "[-season 2023-24]")
+	leagueCode := fs.String("league", "nba", "League to query: nba, wnba or gleague")
+	season := fs.String("season", "", "Season to rank, e.g. 2023-24 (default: current season)")
+	outputFile := fs.String("output", "standings.json", "Output JSON file path")
+	excelFile := fs.String("excel", "standings.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if *season == "" {
+		*season = league.SeasonForDate(time.Now())
+	}
+
+	played, schedule, err := seasonStrength(league, *season)
+	if err != nil {
+		log.Fatalf("Error fetching %s schedule: %v", league.Name, err)
+	}
+	table := standings.Build(league, played, *season)
+	table.AddSchedules(schedule)
+	printStandings(table)
+
+	if err := saveJSON(table, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateStandingsReport(table, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printStandings(table *standings.Standings) {
+	fmt.Printf("\nStandings (%s)\n", table.Season)
+	group := ""
+	for _, team := range table.Teams {
+		if team.Group != group {
+			group = team.Group
+			fmt.Printf("\n  %-3s %-4s %3s %3s %5s %5s %-6s %-6s %-6s %-6s %6s %6s %4s\n", "#", group,
+				"W", "L", "Pct", "GB", "Home", "Road", "L10", "Strk", "SOS", "SOS Lt", "B2B")
+		}
+		gamesBack := "-"
+		if team.GamesBack != 0 {
+			gamesBack = fmt.Sprintf("%.1f", team.GamesBack)
+		}
+		fmt.Printf("  %-3d %-4s %3d %3d %5.3f %5s %-6s %-6s %-6s %-6s", team.Rank, team.Team,
+			team.Wins, team.Losses, team.WinPct, gamesBack, team.Home, team.Road, team.LastTen, team.Streak)
+		if team.Schedule != nil {
+			fmt.Printf(" %6.3f %6.3f %4d", team.Schedule.Played.SOS, team.Schedule.Remaining.SOS, team.Schedule.BackToBacksLeft)
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+}
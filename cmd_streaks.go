@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+func runStreaks(args []string) {
+	fs := newFlagSet("streaks", "[-season 2023-24 | -start-date ... -end-date ...]")
+	query := addQueryFlags(fs)
+	outputFile := fs.String("output", "streaks.json", "Output JSON file path")
+	excelFile := fs.String("excel", "streaks.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	streaks := stats.BuildStreaksReport(games, stats.DefaultWinMilestones)
+	printStreaks(streaks, period)
+
+	if err := saveJSON(streaks, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateStreaksReport(streaks, period, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printStreaks(streaks *stats.StreaksReport, period string) {
+	fmt.Printf("\nStreaks (%s)\n", period)
+	fmt.Printf("  %-5s %-7s %-7s %-5s %-5s %-7s %-7s\n", "Team", "Record", "Current", "LngW", "LngL", "Home", "Away")
+	for _, team := range streaks.Teams {
+		fmt.Printf("  %-5s %-7s %-7s %-5d %-5d %-7s %-7s\n", team.Team, fmt.Sprintf("%d-%d", team.Wins, team.Losses),
+			team.Overall.Current, team.Overall.LongestWin.Length, team.Overall.LongestLoss.Length,
+			team.Home.Current, team.Away.Current)
+	}
+
+	if len(streaks.Milestones) > 0 {
+		fmt.Println("\nMilestones:")
+		for _, milestone := range streaks.Milestones {
+			fmt.Printf("  %s  %s\n", milestone.Date, milestone.Description)
+		}
+	}
+	fmt.Println()
+}
+
+// streakHighlights describes the milestones reached over games for summaries.
+// Streaks and win totals run from the start of the season of startDate, so the
+// games before it are fetched too, but only milestones from startDate on are
+// reported.
+func streakHighlights(dateService *nba.DateService, startDate string, games []nba.Game) ([]string, error) {
+	history, err := seasonHistory(dateService, startDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var highlights []string
+	all := append(append([]nba.Game(nil), history...), games...)
+	for _, milestone := range stats.DetectMilestones(all, stats.DefaultWinMilestones) {
+		if milestone.Date >= startDate {
+			highlights = append(highlights, milestone.Description)
+		}
+	}
+	return highlights, nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+func runTeamStats(args []string) {
+	fs := newFlagSet("teamstats", "[-team LAL,BOS] [-season 2023-24 | -start-date ... -end-date ...] [options]")
+	query := addQueryFlags(fs)
+	teams := fs.String("team", "", "Only report these teams (e.g., LAL,BOS)")
+	perGame := fs.Bool("per-game", false, "Print every game as well as the aggregates")
+	outputFile := fs.String("output", "teamstats.json", "Output JSON file path")
+	excelFile := fs.String("excel", "teamstats.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	boxScores, err := dateService.GetBoxScores(games)
+	if err != nil {
+		log.Fatalf("Error fetching box scores: %v", err)
+	}
+
+	teamStats := stats.BuildTeamStats(dateService.League(), boxScores, period).ForTeams(nba.ParseList(*teams))
+
+	printTeamStats(teamStats, *perGame)
+
+	if err := saveJSON(teamStats, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateTeamStatsReport(teamStats, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printTeamStats(teamStats *stats.TeamStatsReport, perGame bool) {
+	fmt.Printf("\nTeam Efficiency (%s)\n", teamStats.Period)
+	fmt.Printf("  %-4s %3s %6s %6s %6s %6s %6s %6s %6s %6s\n", "Team", "GP", "Pace", "ORtg", "DRtg", "Net", "eFG%", "TOV%", "ORB%", "FTr")
+	for _, team := range teamStats.Season {
+		fmt.Printf("  %-4s %3d %s\n", team.Team, team.Games, efficiencyColumns(team.Efficiency))
+	}
+
+	if perGame {
+		fmt.Println("\nGames")
+		for _, game := range teamStats.Games {
+			matchup := "@ " + game.Opponent
+			if game.Home {
+				matchup = "vs " + game.Opponent
+			}
+			fmt.Printf("  %s %-4s %-7s %s\n", game.Date, game.Team, matchup, efficiencyColumns(game.Efficiency))
+		}
+	}
+	fmt.Println()
+}
+
+// efficiencyColumns formats pace, ratings and the four factors for a table row
+func efficiencyColumns(e stats.Efficiency) string {
+	return fmt.Sprintf("%6.1f %6.1f %6.1f %+6.1f %6.1f %6.1f %6.1f %6.3f",
+		e.Pace, e.OffensiveRating, e.DefensiveRating, e.NetRating,
+		e.EffectiveFGPct*100, e.TurnoverPct*100, e.OffensiveReboundPct*100, e.FreeThrowRate)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jeremielumandong/nba-result/internal/report"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+func runTrends(args []string) {
+	fs := newFlagSet("trends", "[-season 2023-24 | -start-date ... -end-date ...]")
+	query := addQueryFlags(fs)
+	outputFile := fs.String("output", "trends.json", "Output JSON file path")
+	excelFile := fs.String("excel", "trends.xlsx", "Output Excel file path")
+	fs.Parse(args)
+
+	dateService := query.dateService()
+	fmt.Printf("Fetching %s games...\n", dateService.League().Name)
+	games, period := query.games(dateService)
+
+	trends := stats.BuildTrendsReport(games, period)
+	printTrends(trends)
+
+	if err := saveJSON(trends, *outputFile); err != nil {
+		log.Fatalf("Error saving JSON: %v", err)
+	}
+	fmt.Printf("JSON results saved to: %s\n", *outputFile)
+
+	reporter := report.NewExcelReporter()
+	if err := reporter.GenerateTrendsReport(trends, *excelFile); err != nil {
+		log.Fatalf("Error generating Excel report: %v", err)
+	}
+	fmt.Printf("Excel report saved to: %s\n", *excelFile)
+}
+
+func printTrends(trends *stats.TrendsReport) {
+	fmt.Printf("\nLeague Trends (%s, %d games)\n", trends.Period, trends.Games)
+	fmt.Printf("  Home teams:     %d-%d (%.1f%%), average margin %+.1f\n", trends.HomeCourt.HomeWins,
+		trends.HomeCourt.AwayWins, trends.HomeCourt.HomeWinPct*100, trends.HomeCourt.HomeMargin)
+	fmt.Printf("  Overtime:       %d games (%.1f%%)\n", trends.OvertimeGames, trends.OvertimePct*100)
+	fmt.Printf("  Halftime leads: %d of %d held (%.1f%%), %d tied at half\n", trends.Halftime.Held,
+		trends.Halftime.Games, trends.Halftime.HeldPct*100, trends.Halftime.Tied)
+
+	fmt.Printf("\n  %-6s %6s %6s %6s\n", "Period", "Home", "Away", "Total")
+	for _, quarter := range trends.Quarters {
+		if quarter.Games > 0 {
+			fmt.Printf("  %-6s %6.1f %6.1f %6.1f\n", quarter.Period, quarter.Home, quarter.Away, quarter.Total)
+		}
+	}
+
+	fmt.Printf("\n  %-4s %-9s %-9s %7s %7s %6s\n", "Team", "Home", "Road", "HomeMgn", "RoadMgn", "Adv")
+	for _, team := range trends.Teams {
+		homeGames, roadGames := team.HomeWins+team.HomeLosses, team.RoadWins+team.RoadLosses
+		fmt.Printf("  %-4s %-9s %-9s %7s %7s %6s\n", team.Team,
+			fmt.Sprintf("%d-%d", team.HomeWins, team.HomeLosses), fmt.Sprintf("%d-%d", team.RoadWins, team.RoadLosses),
+			signedOrDash(team.HomeMargin, homeGames > 0), signedOrDash(team.RoadMargin, roadGames > 0),
+			signedOrDash(team.Advantage, homeGames > 0 && roadGames > 0))
+	}
+
+	fmt.Printf("\n  %-8s %-10s %5s %6s %7s %6s\n", "Week", "Start", "Games", "Home%", "Margin", "Points")
+	for _, week := range trends.Weekly {
+		fmt.Printf("  %-8s %-10s %5d %5.1f%% %+7.1f %6.1f\n", fmt.Sprintf("Week %d", week.Week), week.StartDate,
+			week.Games, week.HomeWinPct*100, week.HomeMargin, week.PointsPerGame)
+	}
+	fmt.Println()
+}
+
+// signedOrDash formats a margin with its sign, or "-" when no games were played
+func signedOrDash(value float64, played bool) string {
+	if !played {
+		return "-"
+	}
+	return fmt.Sprintf("%+.1f", value)
+}
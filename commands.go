@@ -0,0 +1,103 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// command is a subcommand of the tracker, e.g. "h2h"
+type command struct {
+	name    string
+	summary string
+	run     func(args []string)
+}
+
+// commands returns every available subcommand
+func commands() []command {
+	return []command{
+		{name: "h2h", summary: "Head-to-head meetings between two teams", run: runHeadToHead},
+		{name: "gamelog", summary: "A team's game log and form guide", run: runGameLog},
+		{name: "elo", summary: "Elo ratings, power rankings and rating history", run: runElo},
+		{name: "calibration", summary: "Brier score, log loss and reliability of win predictions", run: runCalibration},
+		{name: "standings", summary: "Standings with strength of schedule played and remaining", run: runStandings},
+		{name: "sos", summary: "Strength of schedule played and remaining, with back-to-backs left", run: runSOS},
+		{name: "simulate", summary: "Monte Carlo playoff odds for the rest of the season", run: runSimulate},
+		{name: "teamstats", summary: "Pace, offensive/defensive rating and four factors from box scores", run: runTeamStats},
+		{name: "leaders", summary: "League leaders, player averages and daily top performers", run: runLeaders},
+		{name: "player", summary: "Player search and game log with minutes, line and plus-minus", run: runPlayer},
+		{name: "feats", summary: "Double-doubles, triple-doubles and other feats with counts per player", run: runFeats},
+		{name: "fantasy", summary: "Fantasy rankings with points or category scoring profiles", run: runFantasy},
+		{name: "matchup", summary: "Weekly fantasy roster matchups with results and projections", run: runMatchup},
+		{name: "ats", summary: "Against-the-spread and over/under records from a closing lines file", run: runATS},
+		{name: "pool", summary: "Pick'em pool weekly and season leaderboards from a picks file", run: runPool},
+		{name: "bracket", summary: "Playoff bracket challenge leaderboard with maximum possible points", run: runBracket},
+		{name: "pythag", summary: "Pythagorean expectation, luck and close-game records", run: runPythag},
+		{name: "fatigue", summary: "Rest, back-to-backs, travel and records under fatigue", run: runFatigue},
+		{name: "trends", summary: "Home-court advantage, scoring by quarter and weekly trends", run: runTrends},
+		{name: "best", summary: "Most watchable games of a day or week", run: runBest},
+		{name: "comebacks", summary: "Biggest comebacks, with lead changes and scoring runs from play-by-play", run: runComebacks},
+		{name: "recap", summary: "Game recaps and a daily digest in Markdown or plain text", run: runRecap},
+		{name: "preview", summary: "Preview cards for scheduled games in Markdown, HTML and Excel", run: runPreview},
+		{name: "streaks", summary: "Winning and losing streaks and record milestones", run: runStreaks},
+	}
+}
+
+// findCommand looks up a subcommand by name
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// queryFlags holds the league and period flags shared by subcommands
+type queryFlags struct {
+	league    *string
+	season    *string
+	startDate *string
+	endDate   *string
+}
+
+// addQueryFlags registers the shared league and period flags on a flag set
+func addQueryFlags(fs *flag.FlagSet) *queryFlags {
+	return &queryFlags{
+		league:    fs.String("league", "nba", "League to query: nba, wnba or gleague"),
+		season:    fs.String("season", "", "Season to query, e.g. 2023-24 (default: current season)"),
+		startDate: fs.String("start-date", "", "Start date for range query (YYYY-MM-DD)"),
+		endDate:   fs.String("end-date", "", "End date for range query (YYYY-MM-DD)"),
+	}
+}
+
+// dateService creates a date service for the selected league
+func (q *queryFlags) dateService() *nba.DateService {
+	league, err := nba.ParseLeague(*q.league)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	return nba.NewDateService(nba.NewLeagueClient(league))
+}
+
+// games fetches the games of the selected season or date range
+func (q *queryFlags) games(dateService *nba.DateService) ([]nba.Game, string) {
+	games, period, err := dateService.GetGamesForPeriod(*q.season, *q.startDate, *q.endDate)
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", dateService.League().Name, err)
+	}
+	return games, period
+}
+
+// newFlagSet creates a flag set for a subcommand with a usage message
+func newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: go run . %s %s\n\nOptions:\n", name, usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
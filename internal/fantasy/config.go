@@ -0,0 +1,159 @@
+package fantasy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Profile types
+const (
+	TypePoints     = "points"     // Fantasy points from weighted stats
+	TypeCategories = "categories" // Categories, ranked by z-score and won head-to-head
+)
+
+// Profile is a fantasy scoring system: a points league with a weight for
+// each stat, or a categories league (roto or head-to-head) with the
+// categories it counts
+type Profile struct {
+	Name       string             `json:"name"`
+	Type       string             `json:"type"`
+	Weights    map[string]float64 `json:"weights,omitempty"`    // Points per stat, for points leagues
+	Categories []string           `json:"categories,omitempty"` // Category keys, for categories leagues
+}
+
+// Config holds the scoring profiles to choose from
+type Config struct {
+	Profiles []Profile `json:"profiles"`
+}
+
+// DefaultProfile is the profile used when none is chosen
+const DefaultProfile = "standard"
+
+// DefaultConfig returns the built-in profiles: Yahoo's and ESPN's default
+// points scoring and standard nine-category scoring
+func DefaultConfig() Config {
+	return Config{Profiles: []Profile{
+		{
+			Name: "standard",
+			Type: TypePoints,
+			Weights: map[string]float64{
+				"points": 1, "rebounds": 1.2, "assists": 1.5, "steals": 3, "blocks": 3, "turnovers": -1,
+			},
+		},
+		{
+			Name: "espn",
+			Type: TypePoints,
+			Weights: map[string]float64{
+				"points": 1, "threes": 1, "field_goals_made": 2, "field_goals_attempted": -1,
+				"free_throws_made": 1, "free_throws_attempted": -1, "rebounds": 1, "assists": 2,
+				"steals": 4, "blocks": 4, "turnovers": -2,
+			},
+		},
+		{
+			Name:       "9cat",
+			Type:       TypeCategories,
+			Categories: []string{"fg_pct", "ft_pct", "threes", "points", "rebounds", "assists", "steals", "blocks", "turnovers"},
+		},
+	}}
+}
+
+// LoadConfig reads scoring profiles from a JSON file
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading config: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+	if len(config.Profiles) == 0 {
+		return Config{}, fmt.Errorf("config %s has no profiles", path)
+	}
+	for _, profile := range config.Profiles {
+		if err := profile.Validate(); err != nil {
+			return Config{}, fmt.Errorf("config %s: %w", path, err)
+		}
+	}
+	return config, nil
+}
+
+// Profile finds a profile by name
+func (c Config) Profile(name string) (Profile, error) {
+	var names []string
+	for _, profile := range c.Profiles {
+		if strings.EqualFold(profile.Name, name) {
+			return profile, nil
+		}
+		names = append(names, profile.Name)
+	}
+	return Profile{}, fmt.Errorf("unknown scoring profile '%s': choose from %s", name, strings.Join(names, ", "))
+}
+
+// Validate checks that a profile's type, weights and categories are known
+func (p Profile) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("profile has no name")
+	}
+	switch p.Type {
+	case TypePoints:
+		if len(p.Weights) == 0 {
+			return fmt.Errorf("points profile '%s' has no weights", p.Name)
+		}
+		for stat := range p.Weights {
+			if _, ok := statValues[stat]; !ok {
+				return fmt.Errorf("profile '%s': unknown stat '%s': use %s", p.Name, stat, strings.Join(statKeys(), ", "))
+			}
+		}
+	case TypeCategories:
+		if len(p.Categories) == 0 {
+			return fmt.Errorf("categories profile '%s' has no categories", p.Name)
+		}
+		for _, key := range p.Categories {
+			if _, ok := findCategory(key); !ok {
+				return fmt.Errorf("profile '%s': unknown category '%s': use %s", p.Name, key, strings.Join(categoryKeys(), ", "))
+			}
+		}
+	default:
+		return fmt.Errorf("profile '%s': unknown type '%s': use %s or %s", p.Name, p.Type, TypePoints, TypeCategories)
+	}
+	return nil
+}
+
+// IsPoints reports whether the profile scores fantasy points
+func (p Profile) IsPoints() bool {
+	return p.Type == TypePoints
+}
+
+// categories returns the profile's categories in the order given
+func (p Profile) categories() []category {
+	var categories []category
+	for _, key := range p.Categories {
+		if c, ok := findCategory(key); ok {
+			categories = append(categories, c)
+		}
+	}
+	return categories
+}
+
+// statKeys lists the stats that can be weighted, sorted
+func statKeys() []string {
+	keys := make([]string, 0, len(statValues))
+	for key := range statValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// categoryKeys lists the categories that can be counted
+func categoryKeys() []string {
+	keys := make([]string, len(allCategories))
+	for i, c := range allCategories {
+		keys[i] = c.key
+	}
+	return keys
+}
@@ -0,0 +1,68 @@
+package fantasy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	config := DefaultConfig()
+	for _, profile := range config.Profiles {
+		assert.NoError(t, profile.Validate(), profile.Name)
+	}
+
+	profile, err := config.Profile("9CAT")
+	require.NoError(t, err)
+	assert.False(t, profile.IsPoints())
+	assert.Len(t, profile.categories(), 9)
+
+	profile, err = config.Profile(DefaultProfile)
+	require.NoError(t, err)
+	assert.True(t, profile.IsPoints())
+
+	_, err = config.Profile("yahoo")
+	assert.EqualError(t, err, "unknown scoring profile 'yahoo': choose from standard, espn, 9cat")
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "scoring.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"profiles": [
+		{"name": "league", "type": "points", "weights": {"points": 1, "double_doubles": 5}},
+		{"name": "8cat", "type": "categories", "categories": ["fg_pct", "points", "turnovers"]}
+	]}`), 0644))
+
+	config, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Len(t, config.Profiles, 2)
+	assert.Equal(t, 5.0, config.Profiles[0].Weights["double_doubles"])
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"no profiles", `{"profiles": []}`, "has no profiles"},
+		{"bad json", `{`, "parsing config"},
+		{"unknown type", `{"profiles": [{"name": "x", "type": "roto"}]}`, "unknown type 'roto'"},
+		{"unknown stat", `{"profiles": [{"name": "x", "type": "points", "weights": {"dunks": 2}}]}`, "unknown stat 'dunks'"},
+		{"unknown category", `{"profiles": [{"name": "x", "type": "categories", "categories": ["dunks"]}]}`, "unknown category 'dunks'"},
+		{"no weights", `{"profiles": [{"name": "x", "type": "points"}]}`, "has no weights"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "bad.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0644))
+			_, err := LoadConfig(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	_, err = LoadConfig(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,309 @@
+package fantasy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+)
+
+// RosterFile lists fantasy teams and who plays whom
+type RosterFile struct {
+	Teams []RosterTeam `json:"teams"`
+	// Pairs are the week's matchups by team name; when empty, teams pair off
+	// in the order they are listed
+	Pairs [][2]string `json:"matchups,omitempty"`
+}
+
+// RosterTeam is a fantasy team's name and players, given by name or ID
+type RosterTeam struct {
+	Name    string   `json:"name"`
+	Players []string `json:"players"`
+}
+
+// Roster is a fantasy team with its players found in the league
+type Roster struct {
+	Name    string       `json:"name"`
+	Players []nba.Player `json:"players"`
+}
+
+// LoadRosterFile reads fantasy rosters from a JSON file
+func LoadRosterFile(path string) (*RosterFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading rosters: %w", err)
+	}
+	var file RosterFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing rosters %s: %w", path, err)
+	}
+	if len(file.Teams) == 0 {
+		return nil, fmt.Errorf("rosters %s has no teams", path)
+	}
+	return &file, nil
+}
+
+// Resolve finds every rostered player in the directory, by ID or by name
+// search, and groups the teams into matchups. A team left without an
+// opponent gets a matchup of its own.
+func (f *RosterFile) Resolve(directory []nba.Player) ([][]Roster, error) {
+	rosters := make(map[string]Roster)
+	for _, team := range f.Teams {
+		if team.Name == "" {
+			return nil, fmt.Errorf("roster team has no name")
+		}
+		if _, ok := rosters[team.Name]; ok {
+			return nil, fmt.Errorf("roster team '%s' is listed twice", team.Name)
+		}
+		roster := Roster{Name: team.Name}
+		for _, name := range team.Players {
+			player, err := findPlayer(directory, name)
+			if err != nil {
+				return nil, fmt.Errorf("team '%s': %w", team.Name, err)
+			}
+			roster.Players = append(roster.Players, player)
+		}
+		rosters[team.Name] = roster
+	}
+
+	pairs := f.Pairs
+	if len(pairs) == 0 {
+		for i := 0; i+1 < len(f.Teams); i += 2 {
+			pairs = append(pairs, [2]string{f.Teams[i].Name, f.Teams[i+1].Name})
+		}
+	}
+
+	var matchups [][]Roster
+	paired := make(map[string]bool)
+	for _, pair := range pairs {
+		var matchup []Roster
+		for _, name := range pair {
+			roster, ok := rosters[name]
+			if !ok {
+				return nil, fmt.Errorf("matchup names unknown team '%s'", name)
+			}
+			if paired[name] {
+				return nil, fmt.Errorf("team '%s' has more than one matchup", name)
+			}
+			paired[name] = true
+			matchup = append(matchup, roster)
+		}
+		matchups = append(matchups, matchup)
+	}
+	for _, team := range f.Teams {
+		if !paired[team.Name] {
+			matchups = append(matchups, []Roster{rosters[team.Name]})
+		}
+	}
+	return matchups, nil
+}
+
+// findPlayer finds a player by ID, or by the one best match for a name
+func findPlayer(directory []nba.Player, name string) (nba.Player, error) {
+	for _, player := range directory {
+		if strings.EqualFold(player.ID, name) {
+			return player, nil
+		}
+	}
+	matches := players.Search(directory, name)
+	if len(matches) == 0 {
+		return nba.Player{}, fmt.Errorf("no player matches '%s'", name)
+	}
+	if len(matches) > 1 && matches[0].Score == matches[1].Score {
+		return nba.Player{}, fmt.Errorf("'%s' matches both %s and %s; use a fuller name or a player ID",
+			name, matches[0].Player.Name, matches[1].Player.Name)
+	}
+	return matches[0].Player, nil
+}
+
+// Week returns the Monday and Sunday of the fantasy week containing date
+func Week(date time.Time) (time.Time, time.Time) {
+	offset := (int(date.Weekday()) + 6) % 7 // Days since Monday
+	start := date.AddDate(0, 0, -offset)
+	return start, start.AddDate(0, 0, 6)
+}
+
+// PlayerWeek is a rostered player's week
+type PlayerWeek struct {
+	PlayerID  string `json:"player_id"`
+	Name      string `json:"name"`
+	Team      string `json:"team"`
+	Played    int    `json:"played"`    // Games played so far this week
+	Remaining int    `json:"remaining"` // Team's games still to play this week
+	// Points and ProjectedPoints are fantasy points so far and projected for
+	// the whole week, in points leagues
+	Points          float64 `json:"points"`
+	ProjectedPoints float64 `json:"projected_points"`
+}
+
+// CategoryValue is a team's total in one category, so far and projected
+type CategoryValue struct {
+	Key        string  `json:"key"`
+	Name       string  `json:"name"`
+	Percentage bool    `json:"percentage,omitempty"`
+	Actual     float64 `json:"actual"`
+	Projected  float64 `json:"projected"`
+}
+
+// TeamWeek is a fantasy team's totals for the week
+type TeamWeek struct {
+	Name            string          `json:"name"`
+	Players         []PlayerWeek    `json:"players"`
+	Points          float64         `json:"points,omitempty"`           // Points leagues
+	ProjectedPoints float64         `json:"projected_points,omitempty"` // Points leagues
+	Categories      []CategoryValue `json:"categories,omitempty"`       // Categories leagues
+	CategoryWins    int             `json:"category_wins,omitempty"`
+	ProjectedWins   int             `json:"projected_wins,omitempty"`
+}
+
+// Matchup is one head-to-head matchup, or a team's week when it has no opponent
+type Matchup struct {
+	Teams           []TeamWeek `json:"teams"`
+	Ties            int        `json:"ties,omitempty"`           // Categories level so far
+	ProjectedTies   int        `json:"projected_ties,omitempty"` // Categories level at the projected finish
+	Leader          string     `json:"leader"`                   // Team ahead so far; empty when level
+	ProjectedWinner string     `json:"projected_winner"`         // Empty when level
+}
+
+// WeekReport holds the results and projections of a fantasy week
+type WeekReport struct {
+	Profile   Profile   `json:"profile"`
+	Start     string    `json:"start"`
+	End       string    `json:"end"`
+	Remaining int       `json:"remaining"` // Games on the week's schedule not yet played
+	Matchups  []Matchup `json:"matchups"`
+}
+
+// BuildWeek scores each matchup over the week from start to end. Results
+// come from the box scores dated in the week; projections add each
+// player's per-game average over all the box scores for every game their
+// team has left in remaining.
+func BuildWeek(profile Profile, matchups [][]Roster, start, end string, boxScores []nba.BoxScore, remaining []nba.Game) *WeekReport {
+	report := &WeekReport{Profile: profile, Start: start, End: end, Matchups: []Matchup{}}
+
+	left := make(map[string]int)
+	for _, game := range remaining {
+		if game.Date < start || game.Date > end || game.IsFinal() {
+			continue
+		}
+		left[strings.ToUpper(game.HomeTeam.Code)]++
+		left[strings.ToUpper(game.AwayTeam.Code)]++
+		report.Remaining++
+	}
+
+	seasons := make(map[string]statLine)
+	weeks := make(map[string]statLine)
+	for _, line := range players.Lines(boxScores) {
+		id := line.Player.PlayerID
+		game := newStatLine(line.Player.Stats)
+		seasons[id] = seasons[id].add(game)
+		if line.Date >= start && line.Date <= end {
+			weeks[id] = weeks[id].add(game)
+		}
+	}
+
+	categories := profile.categories()
+	for _, rosters := range matchups {
+		var matchup Matchup
+		for _, roster := range rosters {
+			team := TeamWeek{Name: roster.Name, Players: []PlayerWeek{}}
+			teamActual, teamProjected := statLine{}, statLine{}
+			for _, player := range roster.Players {
+				week := weeks[player.ID]
+				projection := week.add(seasons[player.ID].perGame().scale(float64(left[player.Team])))
+				teamActual = teamActual.add(week)
+				teamProjected = teamProjected.add(projection)
+
+				playerWeek := PlayerWeek{
+					PlayerID:  player.ID,
+					Name:      player.Name,
+					Team:      player.Team,
+					Played:    int(week[gamesKey]),
+					Remaining: left[player.Team],
+				}
+				if profile.IsPoints() {
+					playerWeek.Points = round(fantasyPoints(profile, week))
+					playerWeek.ProjectedPoints = round(fantasyPoints(profile, projection))
+				}
+				team.Players = append(team.Players, playerWeek)
+			}
+
+			if profile.IsPoints() {
+				team.Points = round(fantasyPoints(profile, teamActual))
+				team.ProjectedPoints = round(fantasyPoints(profile, teamProjected))
+			} else {
+				for _, c := range categories {
+					team.Categories = append(team.Categories, CategoryValue{
+						Key:        c.key,
+						Name:       c.name,
+						Percentage: c.isPercentage(),
+						Actual:     roundCategory(c, c.total(teamActual)),
+						Projected:  roundCategory(c, c.total(teamProjected)),
+					})
+				}
+			}
+			matchup.Teams = append(matchup.Teams, team)
+		}
+
+		if len(matchup.Teams) == 2 {
+			decide(&matchup, profile, categories)
+		}
+		report.Matchups = append(report.Matchups, matchup)
+	}
+	return report
+}
+
+// decide compares the two teams of a matchup so far and at the projected finish
+func decide(matchup *Matchup, profile Profile, categories []category) {
+	a, b := &matchup.Teams[0], &matchup.Teams[1]
+	if profile.IsPoints() {
+		matchup.Leader = ahead(a.Name, b.Name, a.Points, b.Points)
+		matchup.ProjectedWinner = ahead(a.Name, b.Name, a.ProjectedPoints, b.ProjectedPoints)
+		return
+	}
+
+	for i, c := range categories {
+		switch actualA, actualB := a.Categories[i].Actual, b.Categories[i].Actual; {
+		case c.beats(actualA, actualB):
+			a.CategoryWins++
+		case c.beats(actualB, actualA):
+			b.CategoryWins++
+		default:
+			matchup.Ties++
+		}
+		switch projectedA, projectedB := a.Categories[i].Projected, b.Categories[i].Projected; {
+		case c.beats(projectedA, projectedB):
+			a.ProjectedWins++
+		case c.beats(projectedB, projectedA):
+			b.ProjectedWins++
+		default:
+			matchup.ProjectedTies++
+		}
+	}
+	matchup.Leader = ahead(a.Name, b.Name, float64(a.CategoryWins), float64(b.CategoryWins))
+	matchup.ProjectedWinner = ahead(a.Name, b.Name, float64(a.ProjectedWins), float64(b.ProjectedWins))
+}
+
+// ahead names the team with the higher score, or "" when level
+func ahead(nameA, nameB string, a, b float64) string {
+	switch {
+	case a > b:
+		return nameA
+	case b > a:
+		return nameB
+	}
+	return ""
+}
+
+// roundCategory rounds percentages to three places and totals to one
+func roundCategory(c category, value float64) float64 {
+	if c.isPercentage() {
+		return math.Round(value*1000) / 1000
+	}
+	return math.Round(value*10) / 10
+}
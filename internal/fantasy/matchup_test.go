@@ -0,0 +1,139 @@
+package fantasy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	directory := []nba.Player{
+		{ID: "DEN-01", Name: "Nikola Jokić", Team: "DEN"},
+		{ID: "LAL-01", Name: "LeBron James", Team: "LAL"},
+		{ID: "BOS-01", Name: "Jayson Tatum", Team: "BOS"},
+		{ID: "DEN-02", Name: "Jamal Murray", Team: "DEN"},
+		{ID: "MIA-01", Name: "Jimmy Butler", Team: "MIA"},
+	}
+	file := &RosterFile{Teams: []RosterTeam{
+		{Name: "Bigs", Players: []string{"jokic", "BOS-01"}},
+		{Name: "Guards", Players: []string{"Jamal Murray", "lebron"}},
+		{Name: "Solo", Players: []string{"Butler"}},
+	}}
+
+	matchups, err := file.Resolve(directory)
+	require.NoError(t, err)
+	require.Len(t, matchups, 2)
+	require.Len(t, matchups[0], 2, "paired in order")
+	assert.Equal(t, "Bigs", matchups[0][0].Name)
+	assert.Equal(t, "DEN-01", matchups[0][0].Players[0].ID)
+	assert.Equal(t, "Jayson Tatum", matchups[0][0].Players[1].Name)
+	require.Len(t, matchups[1], 1, "odd team out")
+	assert.Equal(t, "Solo", matchups[1][0].Name)
+
+	file.Pairs = [][2]string{{"Solo", "Guards"}}
+	matchups, err = file.Resolve(directory)
+	require.NoError(t, err)
+	assert.Equal(t, "Solo", matchups[0][0].Name)
+	assert.Equal(t, "Bigs", matchups[1][0].Name)
+
+	file.Pairs = [][2]string{{"Solo", "Nobody"}}
+	_, err = file.Resolve(directory)
+	assert.EqualError(t, err, "matchup names unknown team 'Nobody'")
+
+	file.Pairs = nil
+	file.Teams[0].Players = []string{"J"}
+	_, err = file.Resolve(directory)
+	assert.Error(t, err)
+
+	file.Teams[0].Players = []string{"xqzw"}
+	_, err = file.Resolve(directory)
+	assert.EqualError(t, err, "team 'Bigs': no player matches 'xqzw'")
+}
+
+func TestLoadRosterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rosters.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"teams": [{"name": "A", "players": ["jokic"]}, {"name": "B", "players": ["tatum"]}],
+		"matchups": [["B", "A"]]
+	}`), 0644))
+	file, err := LoadRosterFile(path)
+	require.NoError(t, err)
+	assert.Len(t, file.Teams, 2)
+	assert.Equal(t, [][2]string{{"B", "A"}}, file.Pairs)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"teams": []}`), 0644))
+	_, err = LoadRosterFile(path)
+	assert.Error(t, err)
+}
+
+func TestWeek(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+	for _, day := range []string{"2024-01-01", "2024-01-03", "2024-01-07"} {
+		start, end := Week(date(day))
+		assert.Equal(t, "2024-01-01", start.Format("2006-01-02"), day)
+		assert.Equal(t, "2024-01-07", end.Format("2006-01-02"), day)
+	}
+}
+
+func weekRosters() [][]Roster {
+	return [][]Roster{{
+		{Name: "Nuggets", Players: []nba.Player{{ID: "DEN-01", Name: "Nikola Jokić", Team: "DEN"}}},
+		{Name: "Lakers", Players: []nba.Player{{ID: "LAL-01", Name: "LeBron James", Team: "LAL"}}},
+	}}
+}
+
+func TestBuildWeekPoints(t *testing.T) {
+	profile, err := DefaultConfig().Profile("standard")
+	require.NoError(t, err)
+	remaining := []nba.Game{
+		{Date: "2024-01-04", HomeTeam: nba.Team{Code: "LAL"}, AwayTeam: nba.Team{Code: "BOS"}, Status: "Scheduled"},
+		{Date: "2024-01-06", HomeTeam: nba.Team{Code: "LAL"}, AwayTeam: nba.Team{Code: "MIA"}, Status: "Scheduled"},
+		{Date: "2024-01-09", HomeTeam: nba.Team{Code: "DEN"}, AwayTeam: nba.Team{Code: "MIA"}, Status: "Scheduled"},
+	}
+
+	// The week starts on 2024-01-02; LeBron's game on the 1st counts only
+	// towards his average
+	report := BuildWeek(profile, weekRosters(), "2024-01-02", "2024-01-08", testBoxScores(), remaining)
+	assert.Equal(t, 2, report.Remaining, "next week's game is left out")
+	require.Len(t, report.Matchups, 1)
+	matchup := report.Matchups[0]
+	require.Len(t, matchup.Teams, 2)
+
+	nuggets, lakers := matchup.Teams[0], matchup.Teams[1]
+	assert.Equal(t, 61.8, nuggets.Points)
+	assert.Equal(t, 61.8, nuggets.ProjectedPoints, "no games left")
+	assert.Equal(t, 51.1, lakers.Points)
+	assert.Equal(t, round(51.1+2*(52+16.8+30)/2), lakers.ProjectedPoints)
+	assert.Equal(t, 1, lakers.Players[0].Played)
+	assert.Equal(t, 2, lakers.Players[0].Remaining)
+	assert.Equal(t, "Nuggets", matchup.Leader)
+	assert.Equal(t, "Lakers", matchup.ProjectedWinner)
+}
+
+func TestBuildWeekCategories(t *testing.T) {
+	profile := Profile{Name: "3cat", Type: TypeCategories, Categories: []string{"points", "assists", "fg_pct"}}
+	report := BuildWeek(profile, weekRosters(), "2024-01-01", "2024-01-07", testBoxScores(), nil)
+	matchup := report.Matchups[0]
+	nuggets, lakers := matchup.Teams[0], matchup.Teams[1]
+
+	require.Len(t, nuggets.Categories, 3)
+	assert.Equal(t, CategoryValue{Key: "points", Name: "PTS", Actual: 30, Projected: 30}, nuggets.Categories[0])
+	assert.True(t, lakers.Categories[2].Percentage)
+	assert.Equal(t, 52.0, lakers.Categories[0].Actual)
+	assert.Equal(t, 0.5, lakers.Categories[2].Actual)
+
+	assert.Equal(t, 2, lakers.CategoryWins, "points and assists")
+	assert.Equal(t, 0, nuggets.CategoryWins)
+	assert.Equal(t, 1, matchup.Ties, "both shoot 50%")
+	assert.Equal(t, "Lakers", matchup.Leader)
+	assert.Equal(t, "Lakers", matchup.ProjectedWinner)
+}
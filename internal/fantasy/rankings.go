@@ -0,0 +1,163 @@
+package fantasy
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+)
+
+// Ranking defaults
+const (
+	DefaultTopPlayers   = 25
+	DefaultDailyPlayers = 5
+)
+
+// Ranking is a player's fantasy value over a period or in one game
+type Ranking struct {
+	Rank     int    `json:"rank"`
+	PlayerID string `json:"player_id"`
+	Name     string `json:"name"`
+	Team     string `json:"team"`
+	Games    int    `json:"games"`
+	// Value is total fantasy points in points leagues, and the sum of the
+	// category z-scores in categories leagues
+	Value      float64            `json:"value"`
+	PerGame    float64            `json:"per_game,omitempty"`   // Fantasy points per game
+	Categories map[string]float64 `json:"categories,omitempty"` // Z-score in each category
+	Line       string             `json:"line,omitempty"`       // Box score line, for single games
+}
+
+// DayRankings ranks the best fantasy games of a day
+type DayRankings struct {
+	Date     string    `json:"date"`
+	Rankings []Ranking `json:"rankings"`
+}
+
+// Report ranks players over a period and each day
+type Report struct {
+	Profile  Profile       `json:"profile"`
+	Period   string        `json:"period"`
+	Rankings []Ranking     `json:"rankings"`
+	Daily    []DayRankings `json:"daily"`
+}
+
+// playerLines is one player's games over a period
+type playerLines struct {
+	id, name, team string
+	total          statLine
+}
+
+// BuildReport scores every player in the box scores with a profile, ranking
+// the top players over the period and the best games of each day. Z-scores
+// compare players with everyone in the box scores.
+func BuildReport(boxScores []nba.BoxScore, profile Profile, top, daily int, period string) *Report {
+	report := &Report{Profile: profile, Period: period, Rankings: []Ranking{}, Daily: []DayRankings{}}
+	lines := players.Lines(boxScores)
+	seasons := seasonLines(lines)
+	scorer := newScorer(profile, seasons)
+
+	for _, season := range seasons {
+		report.Rankings = append(report.Rankings, scorer.rank(season.id, season.name, season.team, season.total))
+	}
+	report.Rankings = rankTop(report.Rankings, top)
+
+	byDate := make(map[string][]Ranking)
+	var dates []string
+	for _, line := range lines {
+		if _, ok := byDate[line.Date]; !ok {
+			dates = append(dates, line.Date)
+		}
+		ranking := scorer.rank(line.Player.PlayerID, line.Player.Name, line.Team, newStatLine(line.Player.Stats))
+		ranking.Line = players.StatLine(line.Player.Stats)
+		byDate[line.Date] = append(byDate[line.Date], ranking)
+	}
+	sort.Strings(dates)
+	for _, date := range dates {
+		report.Daily = append(report.Daily, DayRankings{Date: date, Rankings: rankTop(byDate[date], daily)})
+	}
+	return report
+}
+
+// seasonLines adds up each player's lines, in order of first appearance
+func seasonLines(lines []players.GameLine) []*playerLines {
+	byPlayer := make(map[string]*playerLines)
+	var seasons []*playerLines
+	for _, line := range lines {
+		season, ok := byPlayer[line.Player.PlayerID]
+		if !ok {
+			season = &playerLines{id: line.Player.PlayerID, name: line.Player.Name, total: statLine{}}
+			byPlayer[line.Player.PlayerID] = season
+			seasons = append(seasons, season)
+		}
+		season.team = line.Team
+		season.total = season.total.add(newStatLine(line.Player.Stats))
+	}
+	return seasons
+}
+
+// scorer values stat lines with a profile
+type scorer struct {
+	profile    Profile
+	categories []category
+	pool       zPool
+}
+
+// newScorer prepares a profile, measuring the categories over seasons
+func newScorer(profile Profile, seasons []*playerLines) scorer {
+	s := scorer{profile: profile, categories: profile.categories()}
+	if !profile.IsPoints() {
+		totals := make([]statLine, len(seasons))
+		for i, season := range seasons {
+			totals[i] = season.total
+		}
+		s.pool = newZPool(totals, s.categories)
+	}
+	return s
+}
+
+// rank scores a player's line, leaving the rank to be set
+func (s scorer) rank(id, name, team string, line statLine) Ranking {
+	ranking := Ranking{PlayerID: id, Name: name, Team: team, Games: int(line[gamesKey])}
+	if s.profile.IsPoints() {
+		ranking.Value = round(fantasyPoints(s.profile, line))
+		if ranking.Games > 0 {
+			ranking.PerGame = round(ranking.Value / float64(ranking.Games))
+		}
+		return ranking
+	}
+
+	scores, sum := s.pool.zScores(line, s.categories)
+	for key, z := range scores {
+		scores[key] = round(z)
+	}
+	ranking.Categories = scores
+	ranking.Value = round(sum)
+	return ranking
+}
+
+// rankTop sorts rankings by value and numbers the top n, sharing ranks on ties
+func rankTop(rankings []Ranking, n int) []Ranking {
+	sort.SliceStable(rankings, func(i, j int) bool {
+		if rankings[i].Value != rankings[j].Value {
+			return rankings[i].Value > rankings[j].Value
+		}
+		return rankings[i].PlayerID < rankings[j].PlayerID
+	})
+	if n > 0 && len(rankings) > n {
+		rankings = rankings[:n]
+	}
+	for i := range rankings {
+		rankings[i].Rank = i + 1
+		if i > 0 && rankings[i].Value == rankings[i-1].Value {
+			rankings[i].Rank = rankings[i-1].Rank
+		}
+	}
+	return rankings
+}
+
+// round rounds to two decimal places
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,93 @@
+package fantasy
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// line builds a player's box score line from points, rebounds and assists,
+// with every point scored on two-point field goals out of twice the attempts
+func line(id, name string, points, rebounds, assists int) nba.PlayerStats {
+	return nba.PlayerStats{
+		PlayerID: id,
+		Name:     name,
+		Stats: nba.TeamStats{
+			Points:              points,
+			FieldGoalsMade:      points / 2,
+			FieldGoalsAttempted: points,
+			DefensiveRebounds:   rebounds,
+			Assists:             assists,
+		},
+	}
+}
+
+func box(date, home, away string, homePlayers, awayPlayers []nba.PlayerStats) nba.BoxScore {
+	return nba.BoxScore{
+		GameID:   date + home + away,
+		Date:     date,
+		HomeTeam: nba.TeamBoxScore{Code: home, Players: homePlayers},
+		AwayTeam: nba.TeamBoxScore{Code: away, Players: awayPlayers},
+	}
+}
+
+func testBoxScores() []nba.BoxScore {
+	return []nba.BoxScore{
+		box("2024-01-02", "DEN", "LAL",
+			[]nba.PlayerStats{line("DEN-01", "Nikola Jokić", 30, 14, 10), line("DEN-02", "Jamal Murray", 20, 4, 6)},
+			[]nba.PlayerStats{line("LAL-01", "LeBron James", 28, 8, 9)}),
+		box("2024-01-01", "LAL", "BOS",
+			[]nba.PlayerStats{line("LAL-01", "LeBron James", 24, 6, 11)},
+			[]nba.PlayerStats{line("BOS-01", "Jayson Tatum", 36, 9, 4)}),
+	}
+}
+
+func TestBuildReportPoints(t *testing.T) {
+	profile, err := DefaultConfig().Profile("standard")
+	require.NoError(t, err)
+
+	report := BuildReport(testBoxScores(), profile, 3, 1, "2024-01-01 to 2024-01-02")
+	require.Len(t, report.Rankings, 3, "top 3")
+	assert.Equal(t, "LAL-01", report.Rankings[0].PlayerID, "two games")
+	assert.Equal(t, 2, report.Rankings[0].Games)
+	assert.Equal(t, 52+16.8+30.0, report.Rankings[0].Value)
+	assert.Equal(t, round(report.Rankings[0].Value/2), report.Rankings[0].PerGame)
+	assert.Equal(t, "DEN-01", report.Rankings[1].PlayerID)
+	assert.Nil(t, report.Rankings[0].Categories)
+
+	require.Len(t, report.Daily, 2)
+	assert.Equal(t, "2024-01-01", report.Daily[0].Date)
+	require.Len(t, report.Daily[0].Rankings, 1)
+	assert.Equal(t, "BOS-01", report.Daily[0].Rankings[0].PlayerID)
+	assert.Equal(t, "36 PTS, 9 REB, 4 AST", report.Daily[0].Rankings[0].Line)
+	assert.Equal(t, "DEN-01", report.Daily[1].Rankings[0].PlayerID)
+}
+
+func TestBuildReportCategories(t *testing.T) {
+	profile, err := DefaultConfig().Profile("9cat")
+	require.NoError(t, err)
+
+	report := BuildReport(testBoxScores(), profile, 0, 0, "")
+	require.Len(t, report.Rankings, 4, "no limit")
+	assert.Equal(t, "DEN-01", report.Rankings[0].PlayerID)
+	assert.Len(t, report.Rankings[0].Categories, 9)
+	assert.Greater(t, report.Rankings[0].Categories["rebounds"], 0.0)
+	assert.Equal(t, "DEN-02", report.Rankings[3].PlayerID)
+	for i := 1; i < len(report.Rankings); i++ {
+		assert.GreaterOrEqual(t, report.Rankings[i-1].Value, report.Rankings[i].Value)
+	}
+}
+
+func TestRankTop(t *testing.T) {
+	rankings := rankTop([]Ranking{
+		{PlayerID: "c", Value: 10},
+		{PlayerID: "a", Value: 30},
+		{PlayerID: "b", Value: 30},
+		{PlayerID: "d", Value: 5},
+	}, 3)
+	require.Len(t, rankings, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{rankings[0].PlayerID, rankings[1].PlayerID, rankings[2].PlayerID})
+	assert.Equal(t, []int{1, 1, 3}, []int{rankings[0].Rank, rankings[1].Rank, rankings[2].Rank})
+}
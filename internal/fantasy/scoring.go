@@ -0,0 +1,236 @@
+package fantasy
+
+import (
+	"math"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+)
+
+// statLine holds counting stats by key as floats, so that averages and
+// projections keep their fractions
+type statLine map[string]float64
+
+// statValues are the stats a points profile can weight
+var statValues = map[string]func(nba.TeamStats) float64{
+	"points":                func(s nba.TeamStats) float64 { return float64(s.Points) },
+	"rebounds":              func(s nba.TeamStats) float64 { return float64(s.Rebounds()) },
+	"offensive_rebounds":    func(s nba.TeamStats) float64 { return float64(s.OffensiveRebounds) },
+	"defensive_rebounds":    func(s nba.TeamStats) float64 { return float64(s.DefensiveRebounds) },
+	"assists":               func(s nba.TeamStats) float64 { return float64(s.Assists) },
+	"steals":                func(s nba.TeamStats) float64 { return float64(s.Steals) },
+	"blocks":                func(s nba.TeamStats) float64 { return float64(s.Blocks) },
+	"turnovers":             func(s nba.TeamStats) float64 { return float64(s.Turnovers) },
+	"fouls":                 func(s nba.TeamStats) float64 { return float64(s.PersonalFouls) },
+	"threes":                func(s nba.TeamStats) float64 { return float64(s.ThreePointersMade) },
+	"three_attempts":        func(s nba.TeamStats) float64 { return float64(s.ThreePointersAttempted) },
+	"field_goals_made":      func(s nba.TeamStats) float64 { return float64(s.FieldGoalsMade) },
+	"field_goals_attempted": func(s nba.TeamStats) float64 { return float64(s.FieldGoalsAttempted) },
+	"free_throws_made":      func(s nba.TeamStats) float64 { return float64(s.FreeThrowsMade) },
+	"free_throws_attempted": func(s nba.TeamStats) float64 { return float64(s.FreeThrowsAttempted) },
+	"double_doubles":        func(s nba.TeamStats) float64 { return boolValue(players.DoubleDigits(s) >= 2) },
+	"triple_doubles":        func(s nba.TeamStats) float64 { return boolValue(players.DoubleDigits(s) >= 3) },
+}
+
+// gamesKey counts the games in a stat line
+const gamesKey = "games"
+
+// newStatLine converts one game's box score line
+func newStatLine(s nba.TeamStats) statLine {
+	line := statLine{gamesKey: 1}
+	for key, value := range statValues {
+		line[key] = value(s)
+	}
+	return line
+}
+
+// add returns the sum of two lines
+func (l statLine) add(other statLine) statLine {
+	sum := make(statLine, len(l))
+	for key, value := range l {
+		sum[key] = value
+	}
+	for key, value := range other {
+		sum[key] += value
+	}
+	return sum
+}
+
+// scale returns the line multiplied by a factor
+func (l statLine) scale(factor float64) statLine {
+	scaled := make(statLine, len(l))
+	for key, value := range l {
+		scaled[key] = value * factor
+	}
+	return scaled
+}
+
+// perGame returns the line averaged over its games
+func (l statLine) perGame() statLine {
+	if l[gamesKey] == 0 {
+		return statLine{}
+	}
+	return l.scale(1 / l[gamesKey])
+}
+
+// fantasyPoints scores a line with a points profile's weights
+func fantasyPoints(profile Profile, line statLine) float64 {
+	total := 0.0
+	for stat, weight := range profile.Weights {
+		total += weight * line[stat]
+	}
+	return total
+}
+
+// category is one category of a categories league
+type category struct {
+	key, name       string
+	stat            string // Counting stat
+	made, attempted string // Percentage categories are made over attempted
+	lowerIsBetter   bool
+}
+
+var allCategories = []category{
+	{key: "fg_pct", name: "FG%", made: "field_goals_made", attempted: "field_goals_attempted"},
+	{key: "ft_pct", name: "FT%", made: "free_throws_made", attempted: "free_throws_attempted"},
+	{key: "three_pct", name: "3P%", made: "threes", attempted: "three_attempts"},
+	{key: "threes", name: "3PM", stat: "threes"},
+	{key: "points", name: "PTS", stat: "points"},
+	{key: "rebounds", name: "REB", stat: "rebounds"},
+	{key: "offensive_rebounds", name: "OREB", stat: "offensive_rebounds"},
+	{key: "assists", name: "AST", stat: "assists"},
+	{key: "steals", name: "STL", stat: "steals"},
+	{key: "blocks", name: "BLK", stat: "blocks"},
+	{key: "turnovers", name: "TO", stat: "turnovers", lowerIsBetter: true},
+	{key: "double_doubles", name: "DD", stat: "double_doubles"},
+}
+
+// findCategory looks a category up by key
+func findCategory(key string) (category, bool) {
+	for _, c := range allCategories {
+		if c.key == key {
+			return c, true
+		}
+	}
+	return category{}, false
+}
+
+// CategoryName returns a category's short name, e.g. "FG%"
+func CategoryName(key string) string {
+	if c, ok := findCategory(key); ok {
+		return c.name
+	}
+	return key
+}
+
+// isPercentage reports whether the category is a shooting percentage
+func (c category) isPercentage() bool {
+	return c.stat == ""
+}
+
+// total returns a line's value in the category: a percentage, or the
+// counting stat summed over the line's games
+func (c category) total(line statLine) float64 {
+	if c.isPercentage() {
+		if line[c.attempted] == 0 {
+			return 0
+		}
+		return line[c.made] / line[c.attempted]
+	}
+	return line[c.stat]
+}
+
+// beats reports whether value a wins the category against value b
+func (c category) beats(a, b float64) bool {
+	if c.lowerIsBetter {
+		return a < b
+	}
+	return a > b
+}
+
+// zPool holds the mean and spread of each category's per-game value over a
+// pool of players, to turn per-game values into z-scores
+type zPool struct {
+	mean, std  map[string]float64
+	percentage map[string]float64 // Pool-wide rate of each percentage category
+}
+
+// newZPool measures the categories over season lines, one per player
+func newZPool(seasons []statLine, categories []category) zPool {
+	pool := zPool{mean: map[string]float64{}, std: map[string]float64{}, percentage: map[string]float64{}}
+
+	total := statLine{}
+	for _, season := range seasons {
+		total = total.add(season)
+	}
+	for _, c := range categories {
+		if c.isPercentage() {
+			pool.percentage[c.key] = c.total(total)
+		}
+	}
+
+	for _, c := range categories {
+		var values []float64
+		for _, season := range seasons {
+			values = append(values, pool.value(c, season))
+		}
+		pool.mean[c.key], pool.std[c.key] = meanAndStd(values)
+	}
+	return pool
+}
+
+// value is a line's per-game value in a category. Percentages are weighed by
+// volume: the shots made above or below the pool's rate, per game.
+func (p zPool) value(c category, line statLine) float64 {
+	games := line[gamesKey]
+	if games == 0 {
+		return 0
+	}
+	if c.isPercentage() {
+		return (line[c.made] - p.percentage[c.key]*line[c.attempted]) / games
+	}
+	return line[c.stat] / games
+}
+
+// zScores returns a line's z-score in each category and their sum
+func (p zPool) zScores(line statLine, categories []category) (map[string]float64, float64) {
+	scores := make(map[string]float64, len(categories))
+	sum := 0.0
+	for _, c := range categories {
+		z := 0.0
+		if std := p.std[c.key]; std > 0 {
+			z = (p.value(c, line) - p.mean[c.key]) / std
+		}
+		if c.lowerIsBetter {
+			z = -z
+		}
+		scores[c.key] = z
+		sum += z
+	}
+	return scores, sum
+}
+
+// meanAndStd returns the mean and population standard deviation of values
+func meanAndStd(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
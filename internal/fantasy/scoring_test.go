@@ -0,0 +1,78 @@
+package fantasy
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFantasyPoints(t *testing.T) {
+	profile, err := DefaultConfig().Profile("standard")
+	require.NoError(t, err)
+
+	stats := nba.TeamStats{Points: 30, DefensiveRebounds: 10, Assists: 8, Steals: 2, Blocks: 1, Turnovers: 4}
+	line := newStatLine(stats)
+	assert.InDelta(t, 30+12+12+6+3-4, fantasyPoints(profile, line), 1e-9)
+
+	twoGames := line.add(line)
+	assert.Equal(t, 2.0, twoGames[gamesKey])
+	assert.InDelta(t, 2*fantasyPoints(profile, line), fantasyPoints(profile, twoGames), 1e-9)
+	assert.InDelta(t, fantasyPoints(profile, line), fantasyPoints(profile, twoGames.perGame()), 1e-9)
+	assert.Empty(t, statLine{}.perGame())
+
+	weights := Profile{Type: TypePoints, Weights: map[string]float64{"double_doubles": 5, "triple_doubles": 10}}
+	assert.Equal(t, 5.0, fantasyPoints(weights, line), "a double-double is not a triple-double")
+}
+
+func TestCategory(t *testing.T) {
+	line := statLine{"field_goals_made": 9, "field_goals_attempted": 20, "turnovers": 3}
+
+	fg, ok := findCategory("fg_pct")
+	require.True(t, ok)
+	assert.True(t, fg.isPercentage())
+	assert.Equal(t, 0.45, fg.total(line))
+	assert.Equal(t, 0.0, fg.total(statLine{}), "no attempts")
+
+	turnovers, _ := findCategory("turnovers")
+	assert.Equal(t, 3.0, turnovers.total(line))
+	assert.True(t, turnovers.beats(2, 3), "fewer turnovers win")
+	assert.False(t, fg.beats(0.4, 0.45))
+
+	_, ok = findCategory("dunks")
+	assert.False(t, ok)
+	assert.Equal(t, "TO", CategoryName("turnovers"))
+	assert.Equal(t, "dunks", CategoryName("dunks"))
+}
+
+func TestZScores(t *testing.T) {
+	categories := Profile{Categories: []string{"points", "fg_pct", "turnovers"}}.categories()
+	seasons := []statLine{
+		{gamesKey: 2, "points": 60, "field_goals_made": 24, "field_goals_attempted": 40, "turnovers": 2},
+		{gamesKey: 2, "points": 20, "field_goals_made": 8, "field_goals_attempted": 20, "turnovers": 6},
+		{gamesKey: 1, "points": 20, "field_goals_made": 8, "field_goals_attempted": 20, "turnovers": 2},
+	}
+	pool := newZPool(seasons, categories)
+
+	scores, sum := pool.zScores(seasons[0], categories)
+	assert.Greater(t, scores["points"], 0.0)
+	assert.Greater(t, scores["fg_pct"], 0.0, "shoots above the pool's rate")
+	assert.Greater(t, scores["turnovers"], 0.0, "fewest turnovers score best")
+	assert.InDelta(t, scores["points"]+scores["fg_pct"]+scores["turnovers"], sum, 1e-9)
+
+	scores, _ = pool.zScores(seasons[1], categories)
+	assert.Less(t, scores["turnovers"], 0.0)
+
+	// Z-scores over a pool sum to zero in each category
+	total := 0.0
+	for _, season := range seasons {
+		scores, _ := pool.zScores(season, categories)
+		total += scores["points"]
+	}
+	assert.InDelta(t, 0, total, 1e-9)
+
+	mean, std := meanAndStd([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	assert.Equal(t, 5.0, mean)
+	assert.Equal(t, 2.0, std)
+}
@@ -0,0 +1,129 @@
+package nba
+
+import (
+	"math"
+	"strings"
+)
+
+// earthRadiusMiles is the mean radius of the Earth
+const earthRadiusMiles = 3958.8
+
+// Arena is where a team plays its home games
+type Arena struct {
+	Name      string  `json:"name"`
+	City      string  `json:"city"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	UTCOffset int     `json:"utc_offset"` // Standard time offset from UTC in hours
+}
+
+var nbaArenas = map[string]Arena{
+	"ATL": {"State Farm Arena", "Atlanta", 33.7573, -84.3963, -5},
+	"BOS": {"TD Garden", "Boston", 42.3662, -71.0621, -5},
+	"BKN": {"Barclays Center", "Brooklyn", 40.6826, -73.9754, -5},
+	"CHA": {"Spectrum Center", "Charlotte", 35.2251, -80.8392, -5},
+	"CHI": {"United Center", "Chicago", 41.8807, -87.6742, -6},
+	"CLE": {"Rocket Mortgage FieldHouse", "Cleveland", 41.4965, -81.6882, -5},
+	"DAL": {"American Airlines Center", "Dallas", 32.7905, -96.8103, -6},
+	"DEN": {"Ball Arena", "Denver", 39.7487, -105.0077, -7},
+	"DET": {"Little Caesars Arena", "Detroit", 42.3411, -83.0553, -5},
+	"GSW": {"Chase Center", "San Francisco", 37.7680, -122.3877, -8},
+	"HOU": {"Toyota Center", "Houston", 29.7508, -95.3621, -6},
+	"IND": {"Gainbridge Fieldhouse", "Indianapolis", 39.7640, -86.1555, -5},
+	"LAC": {"Intuit Dome", "Inglewood", 33.9450, -118.3430, -8},
+	"LAL": {"Crypto.com Arena", "Los Angeles", 34.0430, -118.2673, -8},
+	"MEM": {"FedExForum", "Memphis", 35.1382, -90.0506, -6},
+	"MIA": {"Kaseya Center", "Miami", 25.7814, -80.1870, -5},
+	"MIL": {"Fiserv Forum", "Milwaukee", 43.0451, -87.9172, -6},
+	"MIN": {"Target Center", "Minneapolis", 44.9795, -93.2761, -6},
+	"NOP": {"Smoothie King Center", "New Orleans", 29.9490, -90.0821, -6},
+	"NYK": {"Madison Square Garden", "New York", 40.7505, -73.9934, -5},
+	"OKC": {"Paycom Center", "Oklahoma City", 35.4634, -97.5151, -6},
+	"ORL": {"Kia Center", "Orlando", 28.5392, -81.3839, -5},
+	"PHI": {"Wells Fargo Center", "Philadelphia", 39.9012, -75.1720, -5},
+	"PHX": {"Footprint Center", "Phoenix", 33.4457, -112.0712, -7},
+	"POR": {"Moda Center", "Portland", 45.5316, -122.6668, -8},
+	"SAC": {"Golden 1 Center", "Sacramento", 38.5802, -121.4997, -8},
+	"SAS": {"Frost Bank Center", "San Antonio", 29.4270, -98.4375, -6},
+	"TOR": {"Scotiabank Arena", "Toronto", 43.6435, -79.3791, -5},
+	"UTA": {"Delta Center", "Salt Lake City", 40.7683, -111.9011, -7},
+	"WAS": {"Capital One Arena", "Washington", 38.8982, -77.0209, -5},
+}
+
+var wnbaArenas = map[string]Arena{
+	"ATL": {"Gateway Center Arena", "College Park", 33.6480, -84.4480, -5},
+	"CHI": {"Wintrust Arena", "Chicago", 41.8536, -87.6210, -6},
+	"CON": {"Mohegan Sun Arena", "Uncasville", 41.4917, -72.0908, -5},
+	"IND": {"Gainbridge Fieldhouse", "Indianapolis", 39.7640, -86.1555, -5},
+	"NYL": {"Barclays Center", "Brooklyn", 40.6826, -73.9754, -5},
+	"WAS": {"CareFirst Arena", "Washington", 38.8444, -76.9937, -5},
+	"DAL": {"College Park Center", "Arlington", 32.7310, -97.1085, -6},
+	"GSV": {"Chase Center", "San Francisco", 37.7680, -122.3877, -8},
+	"LAS": {"Crypto.com Arena", "Los Angeles", 34.0430, -118.2673, -8},
+	"LVA": {"Michelob Ultra Arena", "Las Vegas", 36.0906, -115.1760, -8},
+	"MIN": {"Target Center", "Minneapolis", 44.9795, -93.2761, -6},
+	"PHO": {"Footprint Center", "Phoenix", 33.4457, -112.0712, -7},
+	"SEA": {"Climate Pledge Arena", "Seattle", 47.6221, -122.3540, -8},
+}
+
+var gLeagueArenas = map[string]Arena{
+	"CCG": {"Entertainment & Sports Arena", "Washington", 38.8440, -76.9937, -5},
+	"CLC": {"Wolstein Center", "Cleveland", 41.5016, -81.6735, -5},
+	"CPS": {"Gateway Center Arena", "College Park", 33.6480, -84.4480, -5},
+	"DEL": {"Chase Fieldhouse", "Wilmington", 39.7330, -75.5460, -5},
+	"GBO": {"Greensboro Coliseum", "Greensboro", 36.0590, -79.8250, -5},
+	"GRG": {"Van Andel Arena", "Grand Rapids", 42.9626, -85.6715, -5},
+	"LIN": {"Nassau Coliseum", "Uniondale", 40.7226, -73.5906, -5},
+	"MCC": {"Wayne State Fieldhouse", "Detroit", 42.3566, -83.0700, -5},
+	"MNE": {"Portland Expo", "Portland, ME", 43.6563, -70.2779, -5},
+	"NOB": {"The Arena at Innovation Mile", "Noblesville", 40.0456, -86.0086, -5},
+	"OSC": {"Osceola Heritage Park", "Kissimmee", 28.2650, -81.3650, -5},
+	"RAP": {"Paramount Fine Foods Centre", "Mississauga", 43.5954, -79.6400, -5},
+	"WCB": {"NOW Arena", "Hoffman Estates", 42.0725, -88.1400, -6},
+	"WES": {"Westchester County Center", "White Plains", 41.0330, -73.7680, -5},
+	"WIS": {"Oshkosh Arena", "Oshkosh", 44.0160, -88.5490, -6},
+	"AUS": {"H-E-B Center", "Cedar Park", 30.5300, -97.8200, -6},
+	"BIR": {"Legacy Arena", "Birmingham", 33.5230, -86.8110, -6},
+	"IWA": {"Wells Fargo Arena", "Des Moines", 41.5910, -93.6210, -6},
+	"MEM": {"Landers Center", "Southaven", 34.9650, -90.0100, -6},
+	"MXC": {"Gimnasio Juan de la Barrera", "Mexico City", 19.3840, -99.1780, -6},
+	"OKC": {"Paycom Center", "Oklahoma City", 35.4634, -97.5151, -6},
+	"RCR": {"Chiles Center", "Portland", 45.5730, -122.7270, -8},
+	"RGV": {"Bert Ogden Arena", "Edinburg", 26.2650, -98.1620, -6},
+	"SBL": {"UCLA Health Training Center", "El Segundo", 33.9140, -118.3820, -8},
+	"SCW": {"Kaiser Permanente Arena", "Santa Cruz", 36.9640, -122.0220, -8},
+	"SDC": {"Frontwave Arena", "Oceanside", 33.2340, -117.3020, -8},
+	"SLC": {"Maverik Center", "West Valley City", 40.6860, -111.9500, -7},
+	"STO": {"Adventist Health Arena", "Stockton", 37.9540, -121.2960, -8},
+	"SXF": {"Sanford Pentagon", "Sioux Falls", 43.5300, -96.7300, -6},
+	"TEX": {"Comerica Center", "Frisco", 33.1540, -96.8410, -6},
+	"VAL": {"Mullett Arena", "Tempe", 33.4260, -111.9320, -7},
+}
+
+// Arenas returns the home arenas of a league's teams keyed by team code
+func Arenas(league League) map[string]Arena {
+	switch league.ID {
+	case WNBA.ID:
+		return wnbaArenas
+	case GLeague.ID:
+		return gLeagueArenas
+	default:
+		return nbaArenas
+	}
+}
+
+// LookupArena finds the home arena of a team by its code
+func LookupArena(league League, code string) (Arena, bool) {
+	arena, ok := Arenas(league)[strings.ToUpper(code)]
+	return arena, ok
+}
+
+// DistanceMiles returns the great-circle distance between two arenas
+func DistanceMiles(from, to Arena) float64 {
+	lat1, lat2 := from.Latitude*math.Pi/180, to.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (to.Longitude - from.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMiles * math.Asin(math.Sqrt(h))
+}
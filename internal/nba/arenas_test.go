@@ -0,0 +1,32 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupArena(t *testing.T) {
+	for _, league := range Leagues() {
+		for _, team := range Teams(league) {
+			_, ok := LookupArena(league, team.Code)
+			assert.True(t, ok, "%s %s has an arena", league.Name, team.Code)
+		}
+	}
+
+	arena, ok := LookupArena(NBA, "lal")
+	require.True(t, ok)
+	assert.Equal(t, -8, arena.UTCOffset)
+}
+
+func TestDistanceMiles(t *testing.T) {
+	lal, _ := LookupArena(NBA, "LAL")
+	lac, _ := LookupArena(NBA, "LAC")
+	bos, _ := LookupArena(NBA, "BOS")
+
+	assert.Zero(t, DistanceMiles(lal, lal))
+	assert.InDelta(t, 2600, DistanceMiles(lal, bos), 50)
+	assert.InDelta(t, DistanceMiles(lal, bos), DistanceMiles(bos, lal), 0.001)
+	assert.Less(t, DistanceMiles(lal, lac), 15.0)
+}
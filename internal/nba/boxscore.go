@@ -0,0 +1,112 @@
+package nba
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BoxScore holds the box score of a completed game
+type BoxScore struct {
+	GameID   string       `json:"game_id"`
+	Date     string       `json:"date"`
+	Periods  int          `json:"periods"` // Four quarters plus any overtimes
+	Minutes  int          `json:"minutes"` // Length of the game in minutes
+	HomeTeam TeamBoxScore `json:"home_team"`
+	AwayTeam TeamBoxScore `json:"away_team"`
+}
+
+// TeamBoxScore is one team's side of a box score
+type TeamBoxScore struct {
+	Code    string        `json:"code"`
+	Name    string        `json:"name"`
+	Totals  TeamStats     `json:"totals"`
+	Players []PlayerStats `json:"players,omitempty"` // Starters first; their stats add up to the totals
+}
+
+// PlayerStats is one player's line in a box score
+type PlayerStats struct {
+	PlayerID  string    `json:"player_id"`
+	Name      string    `json:"name"`
+	Starter   bool      `json:"starter"`
+	Minutes   int       `json:"minutes"`
+	PlusMinus int       `json:"plus_minus"` // Team's margin while on the floor
+	Stats     TeamStats `json:"stats"`
+}
+
+// TeamStats are box score counting stats
+type TeamStats struct {
+	Points                 int `json:"points"`
+	FieldGoalsMade         int `json:"field_goals_made"`
+	FieldGoalsAttempted    int `json:"field_goals_attempted"`
+	ThreePointersMade      int `json:"three_pointers_made"`
+	ThreePointersAttempted int `json:"three_pointers_attempted"`
+	FreeThrowsMade         int `json:"free_throws_made"`
+	FreeThrowsAttempted    int `json:"free_throws_attempted"`
+	OffensiveRebounds      int `json:"offensive_rebounds"`
+	DefensiveRebounds      int `json:"defensive_rebounds"`
+	Assists                int `json:"assists"`
+	Steals                 int `json:"steals"`
+	Blocks                 int `json:"blocks"`
+	Turnovers              int `json:"turnovers"`
+	PersonalFouls          int `json:"personal_fouls"`
+}
+
+// Rebounds returns total rebounds
+func (s TeamStats) Rebounds() int {
+	return s.OffensiveRebounds + s.DefensiveRebounds
+}
+
+// Add returns the sum of two sets of stats
+func (s TeamStats) Add(other TeamStats) TeamStats {
+	return TeamStats{
+		Points:                 s.Points + other.Points,
+		FieldGoalsMade:         s.FieldGoalsMade + other.FieldGoalsMade,
+		FieldGoalsAttempted:    s.FieldGoalsAttempted + other.FieldGoalsAttempted,
+		ThreePointersMade:      s.ThreePointersMade + other.ThreePointersMade,
+		ThreePointersAttempted: s.ThreePointersAttempted + other.ThreePointersAttempted,
+		FreeThrowsMade:         s.FreeThrowsMade + other.FreeThrowsMade,
+		FreeThrowsAttempted:    s.FreeThrowsAttempted + other.FreeThrowsAttempted,
+		OffensiveRebounds:      s.OffensiveRebounds + other.OffensiveRebounds,
+		DefensiveRebounds:      s.DefensiveRebounds + other.DefensiveRebounds,
+		Assists:                s.Assists + other.Assists,
+		Steals:                 s.Steals + other.Steals,
+		Blocks:                 s.Blocks + other.Blocks,
+		Turnovers:              s.Turnovers + other.Turnovers,
+		PersonalFouls:          s.PersonalFouls + other.PersonalFouls,
+	}
+}
+
+// TeamAndOpponent returns the given team's side of the box score and its opponent's
+func (b BoxScore) TeamAndOpponent(code string) (TeamBoxScore, TeamBoxScore) {
+	if strings.EqualFold(b.HomeTeam.Code, code) {
+		return b.HomeTeam, b.AwayTeam
+	}
+	return b.AwayTeam, b.HomeTeam
+}
+
+// GetBoxScore fetches the box score of a completed game. The free feeds only
+// publish box scores for recent games, so box scores are generated from the
+// final score like the mock schedule.
+func (c *Client) GetBoxScore(game Game) (*BoxScore, error) {
+	if !game.IsFinal() {
+		return nil, fmt.Errorf("game %s has not finished", game.GameID)
+	}
+	return c.getMockBoxScore(game), nil
+}
+
+// GetBoxScores fetches the box scores of the completed games in games,
+// skipping games that have not finished
+func (ds *DateService) GetBoxScores(games []Game) ([]BoxScore, error) {
+	boxScores := []BoxScore{}
+	for _, game := range games {
+		if !game.IsFinal() {
+			continue
+		}
+		boxScore, err := ds.client.GetBoxScore(game)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch box score for game %s: %w", game.GameID, err)
+		}
+		boxScores = append(boxScores, *boxScore)
+	}
+	return boxScores, nil
+}
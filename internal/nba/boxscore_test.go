@@ -0,0 +1,125 @@
+package nba
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBoxScore(t *testing.T) {
+	client := NewClient()
+	game := client.getMockGamesForDate(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))[0]
+
+	boxScore, err := client.GetBoxScore(game)
+	require.NoError(t, err)
+	assert.Equal(t, game.GameID, boxScore.GameID)
+	assert.Equal(t, 48, boxScore.Minutes)
+
+	for _, side := range []struct {
+		box            TeamBoxScore
+		team, opponent Team
+	}{{boxScore.HomeTeam, game.HomeTeam, game.AwayTeam}, {boxScore.AwayTeam, game.AwayTeam, game.HomeTeam}} {
+		totals := side.box.Totals
+		assert.Equal(t, side.team.Code, side.box.Code)
+		assert.Equal(t, side.team.Score, totals.Points)
+		assert.Equal(t, totals.Points, 2*totals.FieldGoalsMade+totals.ThreePointersMade+totals.FreeThrowsMade)
+		assert.LessOrEqual(t, totals.FieldGoalsMade, totals.FieldGoalsAttempted)
+		assert.LessOrEqual(t, totals.ThreePointersMade, totals.ThreePointersAttempted)
+		assert.LessOrEqual(t, totals.FreeThrowsMade, totals.FreeThrowsAttempted)
+
+		require.Len(t, side.box.Players, rosterSize)
+		sum, minutes, plusMinus := TeamStats{}, 0, 0
+		for _, player := range side.box.Players {
+			line := player.Stats
+			sum = sum.Add(line)
+			minutes += player.Minutes
+			plusMinus += player.PlusMinus
+			assert.Equal(t, line.Points, 2*line.FieldGoalsMade+line.ThreePointersMade+line.FreeThrowsMade)
+			assert.LessOrEqual(t, line.ThreePointersMade, line.FieldGoalsMade)
+			assert.LessOrEqual(t, line.ThreePointersAttempted, line.FieldGoalsAttempted)
+		}
+		assert.Equal(t, totals, sum, "player lines add up to the team totals")
+		assert.Equal(t, 5*boxScore.Minutes, minutes)
+		assert.Equal(t, 5*(side.team.Score-side.opponent.Score), plusMinus)
+		assert.True(t, side.box.Players[0].Starter)
+		assert.False(t, side.box.Players[rosterSize-1].Starter)
+	}
+
+	again, err := client.GetBoxScore(game)
+	require.NoError(t, err)
+	assert.Equal(t, boxScore, again, "box scores are deterministic")
+
+	team, opponent := boxScore.TeamAndOpponent(strings.ToLower(game.HomeTeam.Code))
+	assert.Equal(t, game.HomeTeam.Code, team.Code)
+	assert.Equal(t, game.AwayTeam.Code, opponent.Code)
+
+	game.Status = "Scheduled"
+	_, err = client.GetBoxScore(game)
+	assert.Error(t, err)
+}
+
+func TestGetPlayByPlay(t *testing.T) {
+	client := NewClient()
+	games, err := client.GetGamesForDate(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	game := games[0]
+	require.Len(t, game.HomeTeam.Linescore, 4)
+
+	total := 0
+	for _, points := range game.HomeTeam.Linescore {
+		total += points
+	}
+	assert.Equal(t, game.HomeTeam.Score, total)
+
+	pbp, err := client.GetPlayByPlay(game)
+	require.NoError(t, err)
+	require.NotEmpty(t, pbp.Events)
+	last := pbp.Events[len(pbp.Events)-1]
+	assert.Equal(t, game.HomeTeam.Score, last.HomeScore)
+	assert.Equal(t, game.AwayTeam.Score, last.AwayScore)
+	for i := 1; i < len(pbp.Events); i++ {
+		assert.LessOrEqual(t, pbp.Events[i-1].Elapsed, pbp.Events[i].Elapsed)
+	}
+	assert.Equal(t, 4, last.Period)
+}
+
+func TestPeriodClock(t *testing.T) {
+	assert.Equal(t, "Q3 4:32", PeriodClock(3, FormatClock(272)))
+	assert.Equal(t, "OT2 0:05", PeriodClock(6, FormatClock(5)))
+}
+
+func TestMockLinescores_Overtime(t *testing.T) {
+	game := Game{GameID: "9", Date: "2024-01-15", Status: "Final", Quarter: 6,
+		HomeTeam: Team{Code: "BOS", Score: 130}, AwayTeam: Team{Code: "LAL", Score: 126}}
+
+	home, away := mockLinescores(game)
+	require.Len(t, home, 6)
+	sum := func(periods []int) int {
+		total := 0
+		for _, points := range periods {
+			total += points
+		}
+		return total
+	}
+	assert.Equal(t, 130, sum(home))
+	assert.Equal(t, 126, sum(away))
+	assert.Equal(t, sum(home[:4]), sum(away[:4]), "level after regulation")
+	assert.Equal(t, home[4], away[4], "level after the first overtime")
+}
+
+func TestRoster(t *testing.T) {
+	roster := Roster(NBA, "den")
+	require.Len(t, roster, rosterSize)
+	assert.Equal(t, Player{ID: "DEN-01", Name: "Nikola Jokić", Team: "DEN"}, roster[0])
+	assert.Equal(t, roster, Roster(NBA, "DEN"), "rosters are deterministic")
+
+	names := make(map[string]bool)
+	for _, player := range Roster(GLeague, "SXF") {
+		assert.False(t, names[player.Name], "no duplicate names")
+		names[player.Name] = true
+	}
+	assert.Len(t, names, rosterSize)
+}
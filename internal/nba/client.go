@@ -11,28 +11,46 @@ import (
 type Client struct {
 	httpClient *http.Client
 	baseURL    string
+	league     League
 }
 
 // NewClient creates a new NBA client
 func NewClient() *Client {
+	return NewLeagueClient(NBA)
+}
+
+// NewLeagueClient creates a new client for the given league
+func NewLeagueClient(league League) *Client {
 	return &Client{
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 		baseURL: "https://cdn.nba.com/static/json/liveData",
+		league:  league,
 	}
 }
 
+// League returns the league the client fetches games for
+func (c *Client) League() League {
+	return c.league
+}
+
 // GetGamesForDate fetches NBA games for a specific date
 func (c *Client) GetGamesForDate(date time.Time) ([]Game, error) {
 	// Format date for NBA API (YYYYMMDD)
 	dateStr := date.Format("20060102")
-	url := fmt.Sprintf("%s/scoreboard/todaysScoreboard_00.json", c.baseURL)
+	url := fmt.Sprintf("%s/scoreboard/todaysScoreboard_%s.json", c.baseURL, c.league.ID)
 	
 	// For specific dates other than today, we need to use a different approach
 	// NBA's free API is limited, so we'll use a mock implementation for demonstration
 	if !isToday(date) {
-		return c.getMockGamesForDate(date), nil
+		games := c.getMockGamesForDate(date)
+		if date.After(time.Now()) {
+			markScheduled(games)
+		} else {
+			addMockLinescores(games)
+		}
+		return games, nil
 	}
 
 	resp, err := c.httpClient.Get(url)
@@ -55,8 +73,12 @@ func (c *Client) GetGamesForDate(date time.Time) ([]Game, error) {
 	return c.parseGamesFromAPI(apiResponse), nil
 }
 
-// getMockGamesForDate returns mock NBA game data for demonstration
+// getMockGamesForDate returns mock game data for demonstration
 func (c *Client) getMockGamesForDate(date time.Time) []Game {
+	if c.league.ID != NBA.ID {
+		return c.getMockLeagueGames(date)
+	}
+
 	return []Game{
 		{
 			GameID:    "001",
@@ -91,6 +113,57 @@ func (c *Client) getMockGamesForDate(date time.Time) []Game {
 	}
 }
 
+// getMockLeagueGames builds mock games from the league's team registry,
+// rotating the matchups day by day so that every team gets games
+func (c *Client) getMockLeagueGames(date time.Time) []Game {
+	teams := Teams(c.league)
+	day := date.YearDay()
+	tipOffs := []string{"19:00", "19:30", "22:00"}
+
+	baseScore := 72
+	if c.league.ID == GLeague.ID {
+		baseScore = 100
+	}
+
+	var games []Game
+	for i := 0; i < len(tipOffs); i++ {
+		home := teams[(day*3+2*i)%len(teams)]
+		away := teams[(day*3+2*i+1)%len(teams)]
+		if day%2 == 1 {
+			home, away = away, home
+		}
+
+		homeScore := baseScore + (day*7+i*13)%25
+		awayScore := baseScore + (day*11+i*5)%24
+		if homeScore == awayScore {
+			homeScore += 3
+		}
+
+		games = append(games, Game{
+			GameID:   fmt.Sprintf("%s%d", c.league.ID, i+1),
+			Date:     date.Format("2006-01-02"),
+			Time:     tipOffs[i],
+			HomeTeam: Team{Name: home.Name, Code: home.Code, Score: homeScore},
+			AwayTeam: Team{Name: away.Name, Code: away.Code, Score: awayScore},
+			Status:   "Final",
+			Quarter:  4,
+			TimeLeft: "0:00",
+		})
+	}
+	return games
+}
+
+// markScheduled resets mock games to their pre-game state for future dates
+func markScheduled(games []Game) {
+	for i := range games {
+		games[i].Status = "Scheduled"
+		games[i].HomeTeam.Score = 0
+		games[i].AwayTeam.Score = 0
+		games[i].Quarter = 0
+		games[i].TimeLeft = "12:00"
+	}
+}
+
 // parseGamesFromAPI converts NBA API response to our Game struct
 func (c *Client) parseGamesFromAPI(apiResponse NBAAPIResponse) []Game {
 	var games []Game
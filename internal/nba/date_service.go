@@ -8,6 +8,7 @@ import (
 // DateService handles date-related operations for NBA games
 type DateService struct {
 	client *Client
+	filter *GameFilter
 }
 
 // NewDateService creates a new DateService
@@ -17,6 +18,22 @@ func NewDateService(client *Client) *DateService {
 	}
 }
 
+// SetFilter restricts every query to games matching the filter. Summaries
+// are generated from the filtered games. Pass nil to clear the filter.
+func (ds *DateService) SetFilter(filter *GameFilter) {
+	ds.filter = filter
+}
+
+// Filter returns the filter applied to queries, or nil when unfiltered
+func (ds *DateService) Filter() *GameFilter {
+	return ds.filter
+}
+
+// League returns the league the service queries
+func (ds *DateService) League() League {
+	return ds.client.League()
+}
+
 // GetGamesByDate fetches NBA games for a specific date and returns structured results
 func (ds *DateService) GetGamesByDate(dateStr string) (*GameResults, error) {
 	// Parse the date string
@@ -31,9 +48,9 @@ func (ds *DateService) GetGamesByDate(dateStr string) (*GameResults, error) {
 	}
 
 	// Validate date is not too far in the past (NBA founded in 1946)
-	nbaFoundedYear := 1946
-	if date.Year() < nbaFoundedYear {
-		return nil, fmt.Errorf("date cannot be before NBA was founded (%d)", nbaFoundedYear)
+	league := ds.client.League()
+	if date.Year() < league.FoundedYear {
+		return nil, fmt.Errorf("date cannot be before %s was founded (%d)", league.Name, league.FoundedYear)
 	}
 
 	// Fetch games for the date
@@ -42,20 +59,48 @@ func (ds *DateService) GetGamesByDate(dateStr string) (*GameResults, error) {
 		return nil, fmt.Errorf("failed to fetch games for date %s: %w", dateStr, err)
 	}
 
-	// Create structured result
-	result := &GameResults{
+	return ds.buildResults(dateStr, games), nil
+}
+
+// GetScheduleByDate fetches the games on a date that may be in the future,
+// such as upcoming scheduled games
+func (ds *DateService) GetScheduleByDate(dateStr string) (*GameResults, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format '%s': use YYYY-MM-DD format: %w", dateStr, err)
+	}
+
+	league := ds.client.League()
+	if date.Year() < league.FoundedYear {
+		return nil, fmt.Errorf("date cannot be before %s was founded (%d)", league.Name, league.FoundedYear)
+	}
+
+	games, err := ds.client.GetGamesForDate(date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch games for date %s: %w", dateStr, err)
+	}
+
+	return ds.buildResults(dateStr, games), nil
+}
+
+// buildResults filters the games of a date and wraps them in a structured result
+func (ds *DateService) buildResults(dateStr string, games []Game) *GameResults {
+	league := ds.client.League()
+	games = ds.filter.Apply(games)
+
+	return &GameResults{
 		Date:       dateStr,
+		League:     league.Code,
 		Games:      games,
 		TotalGames: len(games),
 		Summary:    ds.generateSummary(games),
 		Metadata: ResultMetadata{
 			GeneratedAt: time.Now().Format(time.RFC3339),
-			Source:      "NBA API",
+			Source:      fmt.Sprintf("%s API", league.Name),
 			Version:     "1.0",
+			Filter:      ds.filter.String(),
 		},
 	}
-
-	return result, nil
 }
 
 // generateSummary creates a summary of game statuses
@@ -80,25 +125,10 @@ func (ds *DateService) generateSummary(games []Game) GameSummary {
 
 // GetGamesByDateRange fetches NBA games for a date range
 func (ds *DateService) GetGamesByDateRange(startDateStr, endDateStr string) ([]*GameResults, error) {
-	startDate, err := time.Parse("2006-01-02", startDateStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid start date format '%s': use YYYY-MM-DD format: %w", startDateStr, err)
-	}
-
-	endDate, err := time.Parse("2006-01-02", endDateStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid end date format '%s': use YYYY-MM-DD format: %w", endDateStr, err)
-	}
-
-	if endDate.Before(startDate) {
-		return nil, fmt.Errorf("end date cannot be before start date")
-	}
-
 	// Limit range to prevent excessive API calls (max 30 days)
-	maxDays := 30
-	daysDiff := int(endDate.Sub(startDate).Hours() / 24)
-	if daysDiff > maxDays {
-		return nil, fmt.Errorf("date range too large: maximum %d days allowed", maxDays)
+	startDate, endDate, err := parseRange(startDateStr, endDateStr, 30)
+	if err != nil {
+		return nil, err
 	}
 
 	var results []*GameResults
@@ -115,4 +145,70 @@ func (ds *DateService) GetGamesByDateRange(startDateStr, endDateStr string) ([]*
 	}
 
 	return results, nil
-}
\ No newline at end of file
+}
+
+// GetGamesBetween fetches every game between two dates as a single list.
+// Unlike GetGamesByDateRange it allows ranges up to a full season; dates
+// after today are skipped since no results exist for them yet.
+func (ds *DateService) GetGamesBetween(startDateStr, endDateStr string) ([]Game, error) {
+	startDate, endDate, err := parseRange(startDateStr, endDateStr, maxSeasonDays)
+	if err != nil {
+		return nil, err
+	}
+
+	if now := time.Now(); endDate.After(now) {
+		endDate = now
+	}
+
+	games := []Game{}
+	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
+		dateStr := currentDate.Format("2006-01-02")
+		result, err := ds.GetGamesByDate(dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get games for %s: %w", dateStr, err)
+		}
+		games = append(games, result.Games...)
+	}
+
+	return games, nil
+}
+
+// maxSeasonDays limits ranges to one season's worth of days
+const maxSeasonDays = 366
+
+// parseRange parses the dates of a range, checking it runs forwards and
+// spans at most maxDays days
+func parseRange(startDateStr, endDateStr string, maxDays int) (time.Time, time.Time, error) {
+	startDate, err := time.Parse("2006-01-02", startDateStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start date format '%s': use YYYY-MM-DD format: %w", startDateStr, err)
+	}
+
+	endDate, err := time.Parse("2006-01-02", endDateStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end date format '%s': use YYYY-MM-DD format: %w", endDateStr, err)
+	}
+
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end date cannot be before start date")
+	}
+
+	daysDiff := int(endDate.Sub(startDate).Hours() / 24)
+	if daysDiff > maxDays {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range too large: maximum %d days allowed", maxDays)
+	}
+	return startDate, endDate, nil
+}
+
+// GetSeasonGames fetches every game of a season (e.g., "2023-24") played so far
+func (ds *DateService) GetSeasonGames(season string) ([]Game, error) {
+	start, end, err := ds.League().SeasonDates(season)
+	if err != nil {
+		return nil, err
+	}
+	if start.After(time.Now()) {
+		return nil, fmt.Errorf("season %s has not started yet", season)
+	}
+
+	return ds.GetGamesBetween(start.Format("2006-01-02"), end.Format("2006-01-02"))
+}
@@ -3,6 +3,7 @@ package nba
 // GameResults represents the structured result for games on a specific date
 type GameResults struct {
 	Date       string         `json:"date"`
+	League     string         `json:"league,omitempty"`
 	Games      []Game         `json:"games"`
 	TotalGames int            `json:"total_games"`
 	Summary    GameSummary    `json:"summary"`
@@ -11,10 +12,11 @@ type GameResults struct {
 
 // GameSummary provides statistics about games
 type GameSummary struct {
-	Scheduled int `json:"scheduled"`
-	Live      int `json:"live"`
-	Final     int `json:"final"`
-	Other     int `json:"other"`
+	Scheduled  int      `json:"scheduled"`
+	Live       int      `json:"live"`
+	Final      int      `json:"final"`
+	Other      int      `json:"other"`
+	Highlights []string `json:"highlights,omitempty"` // Streaks and milestones across the games
 }
 
 // ResultMetadata contains metadata about the query result
@@ -22,6 +24,7 @@ type ResultMetadata struct {
 	GeneratedAt string `json:"generated_at"`
 	Source      string `json:"source"`
 	Version     string `json:"version"`
+	Filter      string `json:"filter,omitempty"`
 }
 
 // DateQueryRequest represents a request for games by date
@@ -0,0 +1,176 @@
+package nba
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GameFilter selects games by team, conference, division and status.
+// Criteria are combined with AND; values within one criterion with OR.
+// A nil or empty filter matches every game.
+type GameFilter struct {
+	League     League
+	Teams      []string
+	Conference string
+	Division   string
+	Statuses   []string
+}
+
+// NewGameFilter creates an empty filter for a league
+func NewGameFilter(league League) *GameFilter {
+	return &GameFilter{League: league}
+}
+
+// ParseList splits a comma-separated command line value, dropping empty entries
+func ParseList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// IsEmpty reports whether the filter has no criteria
+func (f *GameFilter) IsEmpty() bool {
+	return f == nil || (len(f.Teams) == 0 && f.Conference == "" && f.Division == "" && len(f.Statuses) == 0)
+}
+
+// Validate checks the filter criteria against the league's team registry
+func (f *GameFilter) Validate() error {
+	if f.IsEmpty() {
+		return nil
+	}
+
+	for _, code := range f.Teams {
+		if _, ok := LookupTeam(f.League, code); !ok {
+			return fmt.Errorf("unknown %s team '%s'", f.League.Name, code)
+		}
+	}
+
+	if f.Conference != "" && !f.hasTeamWith(func(t TeamInfo) bool { return sameConference(t.Conference, f.Conference) }) {
+		return fmt.Errorf("unknown %s conference '%s'", f.League.Name, f.Conference)
+	}
+
+	if f.Division != "" && !f.hasTeamWith(func(t TeamInfo) bool { return strings.EqualFold(t.Division, f.Division) }) {
+		return fmt.Errorf("unknown %s division '%s'", f.League.Name, f.Division)
+	}
+
+	for _, status := range f.Statuses {
+		switch strings.ToLower(status) {
+		case "scheduled", "live", "final":
+		default:
+			return fmt.Errorf("unknown game status '%s': use Scheduled, Live or Final", status)
+		}
+	}
+
+	return nil
+}
+
+// hasTeamWith reports whether any team in the league satisfies the predicate
+func (f *GameFilter) hasTeamWith(predicate func(TeamInfo) bool) bool {
+	for _, team := range Teams(f.League) {
+		if predicate(team) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether a game satisfies every criterion of the filter
+func (f *GameFilter) Matches(game Game) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if len(f.Teams) > 0 && !f.matchesTeam(game) {
+		return false
+	}
+
+	if f.Conference != "" && !f.matchesEitherTeam(game, func(t TeamInfo) bool { return sameConference(t.Conference, f.Conference) }) {
+		return false
+	}
+
+	if f.Division != "" && !f.matchesEitherTeam(game, func(t TeamInfo) bool { return strings.EqualFold(t.Division, f.Division) }) {
+		return false
+	}
+
+	if len(f.Statuses) > 0 && !f.matchesStatus(game) {
+		return false
+	}
+
+	return true
+}
+
+// Apply returns the games that match the filter
+func (f *GameFilter) Apply(games []Game) []Game {
+	if f.IsEmpty() {
+		return games
+	}
+
+	filtered := []Game{}
+	for _, game := range games {
+		if f.Matches(game) {
+			filtered = append(filtered, game)
+		}
+	}
+	return filtered
+}
+
+// String describes the filter criteria, e.g. "team=LAL,BOS status=Final"
+func (f *GameFilter) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+
+	var parts []string
+	if len(f.Teams) > 0 {
+		parts = append(parts, "team="+strings.Join(f.Teams, ","))
+	}
+	if f.Conference != "" {
+		parts = append(parts, "conference="+f.Conference)
+	}
+	if f.Division != "" {
+		parts = append(parts, "division="+f.Division)
+	}
+	if len(f.Statuses) > 0 {
+		parts = append(parts, "status="+strings.Join(f.Statuses, ","))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (f *GameFilter) matchesTeam(game Game) bool {
+	for _, code := range f.Teams {
+		if strings.EqualFold(game.HomeTeam.Code, code) || strings.EqualFold(game.AwayTeam.Code, code) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *GameFilter) matchesEitherTeam(game Game, predicate func(TeamInfo) bool) bool {
+	for _, code := range []string{game.HomeTeam.Code, game.AwayTeam.Code} {
+		if team, ok := LookupTeam(f.League, code); ok && predicate(team) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *GameFilter) matchesStatus(game Game) bool {
+	for _, status := range f.Statuses {
+		if strings.EqualFold(game.Status, status) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameConference compares conference names, accepting "Eastern" for "East"
+func sameConference(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "ern")
+	}
+	return normalize(a) == normalize(b)
+}
@@ -0,0 +1,78 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func filterTestGames() []Game {
+	return []Game{
+		{GameID: "001", HomeTeam: Team{Code: "LAL"}, AwayTeam: Team{Code: "BOS"}, Status: "Final"},
+		{GameID: "002", HomeTeam: Team{Code: "GSW"}, AwayTeam: Team{Code: "MIA"}, Status: "Live"},
+		{GameID: "003", HomeTeam: Team{Code: "CHI"}, AwayTeam: Team{Code: "MIL"}, Status: "Scheduled"},
+	}
+}
+
+func gameIDs(games []Game) []string {
+	ids := []string{}
+	for _, game := range games {
+		ids = append(ids, game.GameID)
+	}
+	return ids
+}
+
+func TestGameFilter_Empty(t *testing.T) {
+	var filter *GameFilter
+	assert.True(t, filter.IsEmpty())
+	assert.Len(t, filter.Apply(filterTestGames()), 3)
+	assert.Equal(t, "", filter.String())
+}
+
+func TestGameFilter_Criteria(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   GameFilter
+		expected []string
+	}{
+		{"teams", GameFilter{Teams: []string{"lal", "MIL"}}, []string{"001", "003"}},
+		{"conference", GameFilter{Conference: "Western"}, []string{"001", "002"}},
+		{"division", GameFilter{Division: "Central"}, []string{"003"}},
+		{"status", GameFilter{Statuses: []string{"final", "Live"}}, []string{"001", "002"}},
+		{"combined", GameFilter{Conference: "East", Statuses: []string{"Final"}}, []string{"001"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.filter.League = NBA
+			assert.Equal(t, tc.expected, gameIDs(tc.filter.Apply(filterTestGames())))
+		})
+	}
+}
+
+func TestGameFilter_Validate(t *testing.T) {
+	filter := &GameFilter{League: NBA, Teams: []string{"LAL"}, Conference: "East", Division: "Pacific", Statuses: []string{"Final"}}
+	require.NoError(t, filter.Validate())
+
+	assert.Error(t, (&GameFilter{League: NBA, Teams: []string{"XYZ"}}).Validate())
+	assert.Error(t, (&GameFilter{League: NBA, Conference: "North"}).Validate())
+	assert.Error(t, (&GameFilter{League: WNBA, Division: "Pacific"}).Validate())
+	assert.Error(t, (&GameFilter{League: NBA, Statuses: []string{"Postponed"}}).Validate())
+}
+
+func TestParseList(t *testing.T) {
+	assert.Equal(t, []string{"LAL", "BOS"}, ParseList(" LAL, ,BOS "))
+	assert.Empty(t, ParseList(""))
+}
+
+func TestGetGamesByDate_Filtered(t *testing.T) {
+	dateService := NewDateService(NewClient())
+	dateService.SetFilter(&GameFilter{League: NBA, Teams: []string{"LAL"}})
+
+	result, err := dateService.GetGamesByDate("2024-01-15")
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.TotalGames)
+	assert.Equal(t, 1, result.Summary.Final)
+	assert.Equal(t, "team=LAL", result.Metadata.Filter)
+}
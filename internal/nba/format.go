@@ -0,0 +1,18 @@
+package nba
+
+import "fmt"
+
+// Ordinal formats a number as an ordinal, e.g. "1st", "12th" or "22nd"
+func Ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,16 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdinal(t *testing.T) {
+	assert.Equal(t, "1st", Ordinal(1))
+	assert.Equal(t, "2nd", Ordinal(2))
+	assert.Equal(t, "3rd", Ordinal(3))
+	assert.Equal(t, "11th", Ordinal(11))
+	assert.Equal(t, "22nd", Ordinal(22))
+	assert.Equal(t, "112th", Ordinal(112))
+}
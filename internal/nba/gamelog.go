@@ -0,0 +1,150 @@
+package nba
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GameLogRequest represents a request for a team's game log. Either Season
+// or StartDate and EndDate select the period; LastN keeps only the most
+// recent games when greater than zero.
+type GameLogRequest struct {
+	Team      string `json:"team"`
+	Season    string `json:"season,omitempty"`
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+	LastN     int    `json:"last_n,omitempty"`
+}
+
+// TeamGameLog is a team's completed games in date order
+type TeamGameLog struct {
+	Team   string         `json:"team"`
+	Period string         `json:"period"`
+	Games  []GameLogEntry `json:"games"`
+	Wins   int            `json:"wins"`
+	Losses int            `json:"losses"`
+	Record string         `json:"record"`
+	Streak string         `json:"streak"` // e.g., "W3"
+	Form   string         `json:"form"`   // Results of the logged games, oldest first, e.g. "WWLWW"
+}
+
+// GameLogEntry is one game from the team's point of view
+type GameLogEntry struct {
+	GameID        string `json:"game_id"`
+	Date          string `json:"date"`
+	Opponent      string `json:"opponent"`
+	OpponentName  string `json:"opponent_name"`
+	Home          bool   `json:"home"`
+	Result        string `json:"result"` // W or L
+	TeamScore     int    `json:"team_score"`
+	OpponentScore int    `json:"opponent_score"`
+	Margin        int    `json:"margin"` // Positive in wins
+	Record        string `json:"record"` // Running record after this game
+	Streak        string `json:"streak"` // Streak after this game
+}
+
+// Matchup describes the game as "vs OPP" at home or "@ OPP" on the road
+func (e GameLogEntry) Matchup() string {
+	if e.Home {
+		return "vs " + e.Opponent
+	}
+	return "@ " + e.Opponent
+}
+
+// BuildTeamGameLog builds the game log of a team from its completed games in games
+func BuildTeamGameLog(team string, games []Game) *TeamGameLog {
+	team = strings.ToUpper(team)
+	gameLog := &TeamGameLog{
+		Team:  team,
+		Games: []GameLogEntry{},
+	}
+
+	sorted := append([]Game(nil), games...)
+	SortGames(sorted)
+
+	streakResult, streakLength := "", 0
+	for _, game := range sorted {
+		if !game.HasTeam(team) {
+			continue
+		}
+		winner, ok := game.Winner()
+		if !ok {
+			continue
+		}
+
+		self, opponent := game.TeamAndOpponent(team)
+		result := "L"
+		if strings.EqualFold(winner.Code, team) {
+			result = "W"
+			gameLog.Wins++
+		} else {
+			gameLog.Losses++
+		}
+
+		if result == streakResult {
+			streakLength++
+		} else {
+			streakResult, streakLength = result, 1
+		}
+
+		gameLog.Games = append(gameLog.Games, GameLogEntry{
+			GameID:        game.GameID,
+			Date:          game.Date,
+			Opponent:      opponent.Code,
+			OpponentName:  opponent.Name,
+			Home:          game.IsHome(team),
+			Result:        result,
+			TeamScore:     self.Score,
+			OpponentScore: opponent.Score,
+			Margin:        self.Score - opponent.Score,
+			Record:        fmt.Sprintf("%d-%d", gameLog.Wins, gameLog.Losses),
+			Streak:        fmt.Sprintf("%s%d", streakResult, streakLength),
+		})
+	}
+
+	gameLog.Record = fmt.Sprintf("%d-%d", gameLog.Wins, gameLog.Losses)
+	if streakLength > 0 {
+		gameLog.Streak = fmt.Sprintf("%s%d", streakResult, streakLength)
+	}
+	gameLog.Form = formString(gameLog.Games)
+	return gameLog
+}
+
+// Last trims the log to the most recent n games. The overall record and
+// streak still cover the whole period.
+func (l *TeamGameLog) Last(n int) {
+	if n > 0 && len(l.Games) > n {
+		l.Games = l.Games[len(l.Games)-n:]
+		l.Form = formString(l.Games)
+	}
+}
+
+// formString joins the results of the entries, e.g. "WWLWW"
+func formString(entries []GameLogEntry) string {
+	var form strings.Builder
+	for _, entry := range entries {
+		form.WriteString(entry.Result)
+	}
+	return form.String()
+}
+
+// GetTeamGameLog fetches a team's game log over a season or date range
+func (ds *DateService) GetTeamGameLog(req GameLogRequest) (*TeamGameLog, error) {
+	league := ds.League()
+	if _, ok := LookupTeam(league, req.Team); !ok {
+		return nil, fmt.Errorf("unknown %s team '%s'", league.Name, req.Team)
+	}
+	if req.LastN < 0 {
+		return nil, fmt.Errorf("number of games cannot be negative")
+	}
+
+	games, period, err := ds.GetGamesForPeriod(req.Season, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	gameLog := BuildTeamGameLog(req.Team, games)
+	gameLog.Period = period
+	gameLog.Last(req.LastN)
+	return gameLog, nil
+}
@@ -0,0 +1,65 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gameLogTestGames() []Game {
+	return []Game{
+		{GameID: "1", Date: "2024-01-01", HomeTeam: Team{Code: "LAL", Score: 110}, AwayTeam: Team{Code: "BOS", Score: 100}, Status: "Final"},
+		{GameID: "2", Date: "2024-01-03", HomeTeam: Team{Code: "MIA", Score: 95}, AwayTeam: Team{Code: "LAL", Score: 101}, Status: "Final"},
+		{GameID: "3", Date: "2024-01-05", HomeTeam: Team{Code: "GSW", Score: 120}, AwayTeam: Team{Code: "LAL", Score: 118}, Status: "Final"},
+		{GameID: "4", Date: "2024-01-02", HomeTeam: Team{Code: "CHI", Score: 90}, AwayTeam: Team{Code: "MIL", Score: 99}, Status: "Final"},
+		{GameID: "5", Date: "2024-01-07", HomeTeam: Team{Code: "LAL", Score: 99}, AwayTeam: Team{Code: "DEN", Score: 104}, Status: "Final"},
+		{GameID: "6", Date: "2024-01-09", HomeTeam: Team{Code: "LAL", Score: 0}, AwayTeam: Team{Code: "PHX", Score: 0}, Status: "Scheduled"},
+	}
+}
+
+func TestBuildTeamGameLog(t *testing.T) {
+	gameLog := BuildTeamGameLog("lal", gameLogTestGames())
+
+	require.Len(t, gameLog.Games, 4)
+	assert.Equal(t, "2-2", gameLog.Record)
+	assert.Equal(t, "L2", gameLog.Streak)
+	assert.Equal(t, "WWLL", gameLog.Form)
+
+	second := gameLog.Games[1]
+	assert.Equal(t, "MIA", second.Opponent)
+	assert.False(t, second.Home)
+	assert.Equal(t, "@ MIA", second.Matchup())
+	assert.Equal(t, "W", second.Result)
+	assert.Equal(t, 6, second.Margin)
+	assert.Equal(t, "2-0", second.Record)
+	assert.Equal(t, "W2", second.Streak)
+
+	last := gameLog.Games[3]
+	assert.Equal(t, "vs DEN", last.Matchup())
+	assert.Equal(t, -5, last.Margin)
+	assert.Equal(t, "L2", last.Streak)
+}
+
+func TestTeamGameLog_Last(t *testing.T) {
+	gameLog := BuildTeamGameLog("LAL", gameLogTestGames())
+	gameLog.Last(2)
+
+	require.Len(t, gameLog.Games, 2)
+	assert.Equal(t, "LL", gameLog.Form)
+	assert.Equal(t, "2-2", gameLog.Record)
+	assert.Equal(t, "GSW", gameLog.Games[0].Opponent)
+}
+
+func TestGetTeamGameLog(t *testing.T) {
+	dateService := NewDateService(NewClient())
+
+	gameLog, err := dateService.GetTeamGameLog(GameLogRequest{Team: "BOS", StartDate: "2024-01-01", EndDate: "2024-01-20", LastN: 5})
+	require.NoError(t, err)
+	assert.Len(t, gameLog.Games, 5)
+	assert.Equal(t, "0-20", gameLog.Record)
+	assert.Equal(t, "LLLLL", gameLog.Form)
+
+	_, err = dateService.GetTeamGameLog(GameLogRequest{Team: "XYZ"})
+	assert.Error(t, err)
+}
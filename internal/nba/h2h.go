@@ -0,0 +1,182 @@
+package nba
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HeadToHeadRequest represents a request for the meetings between two teams.
+// Either Season or StartDate and EndDate select the period; when neither is
+// set the current season is used.
+type HeadToHeadRequest struct {
+	TeamA     string `json:"team_a"`
+	TeamB     string `json:"team_b"`
+	Season    string `json:"season,omitempty"`
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+}
+
+// HeadToHead summarizes every completed meeting between two teams
+type HeadToHead struct {
+	TeamA              string        `json:"team_a"`
+	TeamB              string        `json:"team_b"`
+	Period             string        `json:"period"`
+	Meetings           []Meeting     `json:"meetings"`
+	Record             SeriesRecord  `json:"record"`
+	AverageMargin      float64       `json:"average_margin"` // Positive when TeamA outscored TeamB
+	HomeAway           HomeAwaySplit `json:"home_away"`
+	LastMeeting        *Meeting      `json:"last_meeting,omitempty"`
+	LastMeetingSummary string        `json:"last_meeting_summary,omitempty"`
+}
+
+// Meeting represents one completed game between the two teams
+type Meeting struct {
+	GameID   string `json:"game_id"`
+	Date     string `json:"date"`
+	HomeTeam Team   `json:"home_team"`
+	AwayTeam Team   `json:"away_team"`
+	Winner   string `json:"winner"` // Code of the winning team
+	Margin   int    `json:"margin"`
+}
+
+// SeriesRecord is the win-loss record between the two teams
+type SeriesRecord struct {
+	TeamAWins int    `json:"team_a_wins"`
+	TeamBWins int    `json:"team_b_wins"`
+	Summary   string `json:"summary"` // e.g., "LAL leads 3-1"
+}
+
+// HomeAwaySplit is TeamA's record in the meetings, split by venue
+type HomeAwaySplit struct {
+	TeamAHomeWins   int `json:"team_a_home_wins"`
+	TeamAHomeLosses int `json:"team_a_home_losses"`
+	TeamAAwayWins   int `json:"team_a_away_wins"`
+	TeamAAwayLosses int `json:"team_a_away_losses"`
+}
+
+// BuildHeadToHead summarizes the completed meetings between two teams found in games
+func BuildHeadToHead(teamA, teamB string, games []Game) *HeadToHead {
+	teamA, teamB = strings.ToUpper(teamA), strings.ToUpper(teamB)
+	h2h := &HeadToHead{
+		TeamA:    teamA,
+		TeamB:    teamB,
+		Meetings: []Meeting{},
+	}
+
+	sorted := append([]Game(nil), games...)
+	SortGames(sorted)
+
+	totalMargin := 0
+	for _, game := range sorted {
+		if !game.HasTeam(teamA) || !game.HasTeam(teamB) {
+			continue
+		}
+		winner, ok := game.Winner()
+		if !ok {
+			continue
+		}
+
+		h2h.Meetings = append(h2h.Meetings, Meeting{
+			GameID:   game.GameID,
+			Date:     game.Date,
+			HomeTeam: game.HomeTeam,
+			AwayTeam: game.AwayTeam,
+			Winner:   winner.Code,
+			Margin:   game.Margin(),
+		})
+
+		teamAWon := strings.EqualFold(winner.Code, teamA)
+		if teamAWon {
+			h2h.Record.TeamAWins++
+			totalMargin += game.Margin()
+		} else {
+			h2h.Record.TeamBWins++
+			totalMargin -= game.Margin()
+		}
+
+		switch {
+		case game.IsHome(teamA) && teamAWon:
+			h2h.HomeAway.TeamAHomeWins++
+		case game.IsHome(teamA):
+			h2h.HomeAway.TeamAHomeLosses++
+		case teamAWon:
+			h2h.HomeAway.TeamAAwayWins++
+		default:
+			h2h.HomeAway.TeamAAwayLosses++
+		}
+	}
+
+	h2h.Record.Summary = seriesSummary(teamA, teamB, h2h.Record.TeamAWins, h2h.Record.TeamBWins)
+
+	if n := len(h2h.Meetings); n > 0 {
+		h2h.AverageMargin = float64(totalMargin) / float64(n)
+		last := h2h.Meetings[n-1]
+		h2h.LastMeeting = &last
+		h2h.LastMeetingSummary = fmt.Sprintf("%s: %s %d, %s %d (%s by %d)",
+			last.Date, last.AwayTeam.Code, last.AwayTeam.Score, last.HomeTeam.Code, last.HomeTeam.Score, last.Winner, last.Margin)
+	}
+
+	return h2h
+}
+
+// seriesSummary describes a series record, e.g. "LAL leads 3-1"
+func seriesSummary(teamA, teamB string, winsA, winsB int) string {
+	switch {
+	case winsA == 0 && winsB == 0:
+		return "No meetings"
+	case winsA > winsB:
+		return fmt.Sprintf("%s leads %d-%d", teamA, winsA, winsB)
+	case winsB > winsA:
+		return fmt.Sprintf("%s leads %d-%d", teamB, winsB, winsA)
+	default:
+		return fmt.Sprintf("Series tied %d-%d", winsA, winsB)
+	}
+}
+
+// GetHeadToHead fetches the meetings between two teams over a season or date range
+func (ds *DateService) GetHeadToHead(req HeadToHeadRequest) (*HeadToHead, error) {
+	league := ds.League()
+	for _, code := range []string{req.TeamA, req.TeamB} {
+		if _, ok := LookupTeam(league, code); !ok {
+			return nil, fmt.Errorf("unknown %s team '%s'", league.Name, code)
+		}
+	}
+	if strings.EqualFold(req.TeamA, req.TeamB) {
+		return nil, fmt.Errorf("head-to-head needs two different teams")
+	}
+
+	games, period, err := ds.GetGamesForPeriod(req.Season, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	h2h := BuildHeadToHead(req.TeamA, req.TeamB, games)
+	h2h.Period = period
+	return h2h, nil
+}
+
+// GetGamesForPeriod fetches games for a season or an explicit date range and
+// returns them with a label describing the period. When neither is given
+// the current season is used.
+func (ds *DateService) GetGamesForPeriod(season, startDate, endDate string) ([]Game, string, error) {
+	if startDate != "" || endDate != "" {
+		if startDate == "" || endDate == "" {
+			return nil, "", fmt.Errorf("both start date and end date are required for a date range")
+		}
+		games, err := ds.GetGamesBetween(startDate, endDate)
+		if err != nil {
+			return nil, "", err
+		}
+		return games, fmt.Sprintf("%s to %s", startDate, endDate), nil
+	}
+
+	if season == "" {
+		season = ds.League().SeasonForDate(time.Now())
+	}
+	games, err := ds.GetSeasonGames(season)
+	if err != nil {
+		return nil, "", err
+	}
+	return games, season, nil
+}
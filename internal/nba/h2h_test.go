@@ -0,0 +1,66 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildHeadToHead(t *testing.T) {
+	games := []Game{
+		{GameID: "3", Date: "2024-03-01", HomeTeam: Team{Code: "BOS", Score: 120}, AwayTeam: Team{Code: "LAL", Score: 100}, Status: "Final"},
+		{GameID: "1", Date: "2024-01-15", HomeTeam: Team{Code: "LAL", Score: 112}, AwayTeam: Team{Code: "BOS", Score: 108}, Status: "Final"},
+		{GameID: "2", Date: "2024-02-01", HomeTeam: Team{Code: "BOS", Score: 99}, AwayTeam: Team{Code: "LAL", Score: 105}, Status: "Final"},
+		{GameID: "4", Date: "2024-03-05", HomeTeam: Team{Code: "LAL", Score: 0}, AwayTeam: Team{Code: "BOS", Score: 0}, Status: "Scheduled"},
+		{GameID: "5", Date: "2024-01-20", HomeTeam: Team{Code: "LAL", Score: 90}, AwayTeam: Team{Code: "MIA", Score: 80}, Status: "Final"},
+	}
+
+	h2h := BuildHeadToHead("lal", "bos", games)
+
+	require.Len(t, h2h.Meetings, 3)
+	assert.Equal(t, []string{"1", "2", "3"}, []string{h2h.Meetings[0].GameID, h2h.Meetings[1].GameID, h2h.Meetings[2].GameID})
+	assert.Equal(t, 2, h2h.Record.TeamAWins)
+	assert.Equal(t, 1, h2h.Record.TeamBWins)
+	assert.Equal(t, "LAL leads 2-1", h2h.Record.Summary)
+	assert.InDelta(t, -10.0/3.0, h2h.AverageMargin, 0.001)
+	assert.Equal(t, HomeAwaySplit{TeamAHomeWins: 1, TeamAAwayWins: 1, TeamAAwayLosses: 1}, h2h.HomeAway)
+
+	require.NotNil(t, h2h.LastMeeting)
+	assert.Equal(t, "3", h2h.LastMeeting.GameID)
+	assert.Equal(t, "2024-03-01: LAL 100, BOS 120 (BOS by 20)", h2h.LastMeetingSummary)
+}
+
+func TestBuildHeadToHead_NoMeetings(t *testing.T) {
+	h2h := BuildHeadToHead("LAL", "BOS", nil)
+	assert.Empty(t, h2h.Meetings)
+	assert.Nil(t, h2h.LastMeeting)
+	assert.Equal(t, "No meetings", h2h.Record.Summary)
+}
+
+func TestGetHeadToHead(t *testing.T) {
+	dateService := NewDateService(NewClient())
+
+	h2h, err := dateService.GetHeadToHead(HeadToHeadRequest{TeamA: "LAL", TeamB: "BOS", StartDate: "2024-01-15", EndDate: "2024-01-17"})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-15 to 2024-01-17", h2h.Period)
+	assert.Len(t, h2h.Meetings, 3)
+
+	_, err = dateService.GetHeadToHead(HeadToHeadRequest{TeamA: "LAL", TeamB: "XYZ", Season: "2023-24"})
+	assert.Error(t, err)
+
+	_, err = dateService.GetHeadToHead(HeadToHeadRequest{TeamA: "LAL", TeamB: "BOS", StartDate: "2024-01-15"})
+	assert.Error(t, err)
+}
+
+func TestGetGamesBetween(t *testing.T) {
+	dateService := NewDateService(NewClient())
+
+	games, err := dateService.GetGamesBetween("2024-01-01", "2024-02-15")
+	require.NoError(t, err)
+	assert.Len(t, games, 46*3)
+
+	_, err = dateService.GetGamesBetween("2023-01-01", "2024-06-01")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "date range too large")
+}
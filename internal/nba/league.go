@@ -0,0 +1,219 @@
+package nba
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// League describes a basketball league served by the NBA stats feeds
+type League struct {
+	ID          string `json:"id"`   // Feed league ID (e.g., 00, 10, 20)
+	Code        string `json:"code"` // Short code used on the command line (e.g., nba, wnba)
+	Name        string `json:"name"`
+	FoundedYear int    `json:"founded_year"`
+
+	// Season calendar. Seasons that start late in the year end in the
+	// following calendar year and are labelled "2023-24"; seasons played
+	// within one calendar year are labelled "2024".
+	SeasonStartMonth  time.Month `json:"season_start_month"`
+	SeasonEndMonth    time.Month `json:"season_end_month"`
+	PlayoffStartMonth time.Month `json:"playoff_start_month"`
+	PlayoffStartDay   int        `json:"playoff_start_day"`
+
+	// Game clock
+	QuarterMinutes  int `json:"quarter_minutes"`
+	OvertimeMinutes int `json:"overtime_minutes"`
+}
+
+var (
+	// NBA is the National Basketball Association
+	NBA = League{
+		ID:                "00",
+		Code:              "nba",
+		Name:              "NBA",
+		FoundedYear:       1946,
+		SeasonStartMonth:  time.October,
+		SeasonEndMonth:    time.June,
+		PlayoffStartMonth: time.April,
+		PlayoffStartDay:   15,
+		QuarterMinutes:    12,
+		OvertimeMinutes:   5,
+	}
+
+	// WNBA is the Women's National Basketball Association
+	WNBA = League{
+		ID:                "10",
+		Code:              "wnba",
+		Name:              "WNBA",
+		FoundedYear:       1996,
+		SeasonStartMonth:  time.May,
+		SeasonEndMonth:    time.October,
+		PlayoffStartMonth: time.September,
+		PlayoffStartDay:   15,
+		QuarterMinutes:    10,
+		OvertimeMinutes:   5,
+	}
+
+	// GLeague is the NBA G League
+	GLeague = League{
+		ID:                "20",
+		Code:              "gleague",
+		Name:              "G League",
+		FoundedYear:       2001,
+		SeasonStartMonth:  time.November,
+		SeasonEndMonth:    time.April,
+		PlayoffStartMonth: time.April,
+		PlayoffStartDay:   1,
+		QuarterMinutes:    12,
+		OvertimeMinutes:   5,
+	}
+)
+
+// RegulationMinutes returns the length of a game without overtime
+func (l League) RegulationMinutes() int {
+	return 4 * l.QuarterMinutes
+}
+
+// GameMinutes returns the length of a game that lasted the given number of
+// periods, counting each period after the fourth as overtime
+func (l League) GameMinutes(periods int) int {
+	if periods <= 4 {
+		return l.RegulationMinutes()
+	}
+	return l.RegulationMinutes() + (periods-4)*l.OvertimeMinutes
+}
+
+// Leagues returns every supported league
+func Leagues() []League {
+	return []League{NBA, WNBA, GLeague}
+}
+
+// ParseLeague resolves a league from its code, name or feed ID
+func ParseLeague(value string) (League, error) {
+	key := strings.ToLower(strings.TrimSpace(value))
+	key = strings.NewReplacer("-", "", "_", "", " ", "").Replace(key)
+
+	for _, league := range Leagues() {
+		name := strings.ToLower(strings.ReplaceAll(league.Name, " ", ""))
+		if key == league.Code || key == league.ID || key == name {
+			return league, nil
+		}
+	}
+	if key == "g" || key == "dleague" {
+		return GLeague, nil
+	}
+
+	return League{}, fmt.Errorf("unknown league '%s': use nba, wnba or gleague", value)
+}
+
+// String returns the league name
+func (l League) String() string {
+	return l.Name
+}
+
+// spansYears reports whether a season starts in one year and ends in the next
+func (l League) spansYears() bool {
+	return l.SeasonEndMonth < l.SeasonStartMonth
+}
+
+// SeasonForDate returns the season label a date belongs to. Dates in the
+// off-season belong to the season that starts next.
+func (l League) SeasonForDate(date time.Time) string {
+	startYear := date.Year()
+	if l.spansYears() {
+		if date.Month() <= l.SeasonEndMonth {
+			startYear--
+		}
+	} else if date.Month() > l.SeasonEndMonth {
+		startYear++
+	}
+	return l.seasonLabel(startYear)
+}
+
+// seasonLabel formats the label for the season starting in startYear
+func (l League) seasonLabel(startYear int) string {
+	if l.spansYears() {
+		return fmt.Sprintf("%d-%02d", startYear, (startYear+1)%100)
+	}
+	return strconv.Itoa(startYear)
+}
+
+// SeasonDates returns the first and last calendar day of a season
+func (l League) SeasonDates(season string) (time.Time, time.Time, error) {
+	startYear, err := l.parseSeason(season)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	endYear := startYear
+	if l.spansYears() {
+		endYear++
+	}
+
+	start := time.Date(startYear, l.SeasonStartMonth, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(endYear, l.SeasonEndMonth+1, 0, 0, 0, 0, 0, time.UTC)
+	return start, end, nil
+}
+
+// LatestSeasonStart returns the first day of the latest season that started on
+// or before date. Off-season dates fall back to the season just finished, and
+// dates before the league's first season to the date itself.
+func (l League) LatestSeasonStart(date time.Time) time.Time {
+	start, _, err := l.SeasonDates(l.SeasonForDate(date))
+	if err == nil && start.After(date) {
+		start, _, err = l.SeasonDates(l.seasonLabel(start.Year() - 1))
+	}
+	if err != nil || start.After(date) {
+		return date
+	}
+	return start
+}
+
+// PlayoffStart returns the first day of the playoffs in a season
+func (l League) PlayoffStart(season string) (time.Time, error) {
+	startYear, err := l.parseSeason(season)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	year := startYear
+	if l.spansYears() {
+		year++
+	}
+	return time.Date(year, l.PlayoffStartMonth, l.PlayoffStartDay, 0, 0, 0, 0, time.UTC), nil
+}
+
+// parseSeason returns the start year of a season label such as "2023-24" or "2024"
+func (l League) parseSeason(season string) (int, error) {
+	parts := strings.SplitN(strings.TrimSpace(season), "-", 2)
+	startYear, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts[0]) != 4 {
+		return 0, fmt.Errorf("invalid season '%s': use %s format", season, l.seasonLabel(2023))
+	}
+	if startYear < l.FoundedYear {
+		return 0, fmt.Errorf("season %s is before %s was founded (%d)", season, l.Name, l.FoundedYear)
+	}
+
+	if len(parts) == 2 {
+		if !l.spansYears() {
+			return 0, fmt.Errorf("invalid season '%s': %s seasons are labelled by year (e.g., 2024)", season, l.Name)
+		}
+		endYear, err := strconv.Atoi(parts[1])
+		if err != nil || endYear != (startYear+1)%100 {
+			return 0, fmt.Errorf("invalid season '%s': use %s format", season, l.seasonLabel(2023))
+		}
+	}
+
+	return startYear, nil
+}
+
+// InSeason reports whether a date falls between the first and last day of a season
+func (l League) InSeason(date time.Time) bool {
+	start, end, err := l.SeasonDates(l.SeasonForDate(date))
+	if err != nil {
+		return false
+	}
+	return !date.Before(start) && !date.After(end)
+}
@@ -0,0 +1,115 @@
+package nba
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLeague(t *testing.T) {
+	testCases := map[string]League{
+		"nba":      NBA,
+		"00":       NBA,
+		"WNBA":     WNBA,
+		"10":       WNBA,
+		"gleague":  GLeague,
+		"g-league": GLeague,
+		"G League": GLeague,
+		"20":       GLeague,
+	}
+
+	for input, expected := range testCases {
+		t.Run(input, func(t *testing.T) {
+			league, err := ParseLeague(input)
+			require.NoError(t, err)
+			assert.Equal(t, expected.ID, league.ID)
+		})
+	}
+
+	_, err := ParseLeague("euroleague")
+	assert.Error(t, err)
+}
+
+func TestSeasonForDate(t *testing.T) {
+	assert.Equal(t, "2023-24", NBA.SeasonForDate(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2023-24", NBA.SeasonForDate(time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2024-25", NBA.SeasonForDate(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2024", WNBA.SeasonForDate(time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2025", WNBA.SeasonForDate(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestSeasonDates(t *testing.T) {
+	start, end, err := NBA.SeasonDates("2023-24")
+	require.NoError(t, err)
+	assert.Equal(t, "2023-10-01", start.Format("2006-01-02"))
+	assert.Equal(t, "2024-06-30", end.Format("2006-01-02"))
+
+	start, end, err = WNBA.SeasonDates("2024")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-05-01", start.Format("2006-01-02"))
+	assert.Equal(t, "2024-10-31", end.Format("2006-01-02"))
+
+	_, _, err = NBA.SeasonDates("2023-25")
+	assert.Error(t, err)
+	_, _, err = WNBA.SeasonDates("2023-24")
+	assert.Error(t, err)
+	_, _, err = NBA.SeasonDates("1900-01")
+	assert.Error(t, err)
+}
+
+func TestLatestSeasonStart(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	assert.Equal(t, day(2023, time.October, 1), NBA.LatestSeasonStart(day(2024, time.January, 15)))
+	assert.Equal(t, day(2024, time.May, 1), WNBA.LatestSeasonStart(day(2024, time.July, 10)))
+
+	// Off-season dates belong to the next season, which has not started yet
+	assert.Equal(t, day(2023, time.October, 1), NBA.LatestSeasonStart(day(2024, time.August, 1)))
+	assert.Equal(t, day(2024, time.May, 1), WNBA.LatestSeasonStart(day(2024, time.December, 1)))
+
+	// Before the first season there is nothing to fall back to
+	founded := day(WNBA.FoundedYear, time.January, 10)
+	assert.Equal(t, founded, WNBA.LatestSeasonStart(founded))
+}
+
+func TestTeams(t *testing.T) {
+	assert.Len(t, Teams(NBA), 30)
+	assert.NotEmpty(t, Teams(WNBA))
+	assert.NotEmpty(t, Teams(GLeague))
+
+	team, ok := LookupTeam(NBA, "lal")
+	require.True(t, ok)
+	assert.Equal(t, "Pacific", team.Division)
+
+	team, ok = LookupTeam(WNBA, "LVA")
+	require.True(t, ok)
+	assert.Equal(t, "Las Vegas Aces", team.Name)
+
+	_, ok = LookupTeam(WNBA, "LAL")
+	assert.False(t, ok)
+}
+
+func TestLeagueClientMockGames(t *testing.T) {
+	client := NewLeagueClient(WNBA)
+	games, err := client.GetGamesForDate(time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.NotEmpty(t, games)
+
+	for _, game := range games {
+		_, ok := LookupTeam(WNBA, game.HomeTeam.Code)
+		assert.True(t, ok)
+		_, ok = LookupTeam(WNBA, game.AwayTeam.Code)
+		assert.True(t, ok)
+		assert.NotEqual(t, game.HomeTeam.Score, game.AwayTeam.Score)
+	}
+}
+
+func TestLeague_GameMinutes(t *testing.T) {
+	assert.Equal(t, 48, NBA.GameMinutes(4))
+	assert.Equal(t, 58, NBA.GameMinutes(6))
+	assert.Equal(t, 40, WNBA.RegulationMinutes())
+}
@@ -0,0 +1,351 @@
+package nba
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sort"
+)
+
+// mockSource returns a random source seeded from the given keys, so that
+// mock data is the same every time it is generated for the same game
+func mockSource(keys ...string) *rand.Rand {
+	hash := fnv.New64a()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+	}
+	return rand.New(rand.NewSource(int64(hash.Sum64())))
+}
+
+// getMockBoxScore generates a plausible box score that adds up to the final score
+func (c *Client) getMockBoxScore(game Game) *BoxScore {
+	periods := game.Quarter
+	if periods < 4 {
+		periods = 4
+	}
+
+	minutes := c.league.GameMinutes(periods)
+	return &BoxScore{
+		GameID:   game.GameID,
+		Date:     game.Date,
+		Periods:  periods,
+		Minutes:  minutes,
+		HomeTeam: mockTeamBoxScore(c.league, game, game.HomeTeam, game.AwayTeam, minutes),
+		AwayTeam: mockTeamBoxScore(c.league, game, game.AwayTeam, game.HomeTeam, minutes),
+	}
+}
+
+// mockTeamBoxScore generates one team's totals for a final score, shared out
+// between its players
+func mockTeamBoxScore(league League, game Game, team, opponent Team, minutes int) TeamBoxScore {
+	rng := mockSource(game.Date, game.GameID, team.Code)
+	points := team.Score
+	scale := float64(points) / 112 // Counting stats scale with scoring
+
+	threes := int(float64(points)*0.12) + rng.Intn(5) - 2
+	freeThrows := int(float64(points)*0.16) + rng.Intn(5) - 2
+	if threes < 0 {
+		threes = 0
+	}
+	if freeThrows < 0 {
+		freeThrows = 0
+	}
+	for 3*threes+freeThrows > points {
+		if threes > 0 {
+			threes--
+		} else {
+			freeThrows--
+		}
+	}
+	// Whatever is left is scored in twos
+	if (points-3*threes-freeThrows)%2 == 1 {
+		freeThrows++
+	}
+	twos := (points - 3*threes - freeThrows) / 2
+
+	threeAttempts := threes * 100 / (33 + rng.Intn(8))
+	if threeAttempts < threes {
+		threeAttempts = threes
+	}
+	twoAttempts := twos * 100 / (50 + rng.Intn(8))
+	if twoAttempts < twos {
+		twoAttempts = twos
+	}
+	freeThrowAttempts := freeThrows * 100 / (72 + rng.Intn(14))
+	if freeThrowAttempts < freeThrows {
+		freeThrowAttempts = freeThrows
+	}
+
+	box := TeamBoxScore{
+		Code: team.Code,
+		Name: team.Name,
+		Totals: TeamStats{
+			Points:                 points,
+			FieldGoalsMade:         twos + threes,
+			FieldGoalsAttempted:    twoAttempts + threeAttempts,
+			ThreePointersMade:      threes,
+			ThreePointersAttempted: threeAttempts,
+			FreeThrowsMade:         freeThrows,
+			FreeThrowsAttempted:    freeThrowAttempts,
+			OffensiveRebounds:      scaled(8+rng.Intn(6), scale),
+			DefensiveRebounds:      scaled(30+rng.Intn(8), scale),
+			Assists:                (twos + threes) * (55 + rng.Intn(15)) / 100,
+			Steals:                 scaled(5+rng.Intn(5), scale),
+			Blocks:                 scaled(3+rng.Intn(5), scale),
+			Turnovers:              scaled(11+rng.Intn(6), scale),
+			PersonalFouls:          scaled(16+rng.Intn(7), scale),
+		},
+	}
+	box.Players = mockPlayerStats(league, game, team, box.Totals, minutes, points-opponent.Score)
+	return box
+}
+
+// Mock players' shares of the minutes and of the counting stats, by roster spot
+var (
+	mockMinuteWeights = []float64{35, 33, 32, 31, 29, 22, 19, 16, 13, 10}
+	mockUsageWeights  = []float64{1.8, 1.4, 1.2, 1.0, 0.9, 0.8, 0.65, 0.5, 0.4, 0.3}
+)
+
+// mockPlayerStats shares a team's totals out between its roster, so that the
+// players' lines add up to the team's and stay internally consistent
+func mockPlayerStats(league League, game Game, team Team, totals TeamStats, minutes, margin int) []PlayerStats {
+	rng := mockSource(game.Date, game.GameID, team.Code, "players")
+	roster := Roster(league, team.Code)
+	n := len(roster)
+
+	twos := splitTotal(rng, totals.FieldGoalsMade-totals.ThreePointersMade, mockUsageWeights[:n], 0.6)
+	threes := splitTotal(rng, totals.ThreePointersMade, mockUsageWeights[:n], 0.8)
+	missedTwos := splitTotal(rng, totals.FieldGoalsAttempted-totals.FieldGoalsMade-
+		(totals.ThreePointersAttempted-totals.ThreePointersMade), mockUsageWeights[:n], 0.6)
+	missedThrees := splitTotal(rng, totals.ThreePointersAttempted-totals.ThreePointersMade, mockUsageWeights[:n], 0.8)
+	freeThrows := splitTotal(rng, totals.FreeThrowsMade, mockUsageWeights[:n], 0.8)
+	missedFreeThrows := splitTotal(rng, totals.FreeThrowsAttempted-totals.FreeThrowsMade, mockUsageWeights[:n], 0.8)
+	played := splitTotal(rng, minutes*5, mockMinuteWeights[:n], 0.15)
+	offensive := splitTotal(rng, totals.OffensiveRebounds, mockMinuteWeights[:n], 0.9)
+	defensive := splitTotal(rng, totals.DefensiveRebounds, mockMinuteWeights[:n], 0.9)
+	assists := splitTotal(rng, totals.Assists, mockUsageWeights[:n], 0.9)
+	steals := splitTotal(rng, totals.Steals, mockMinuteWeights[:n], 0.9)
+	blocks := splitTotal(rng, totals.Blocks, mockMinuteWeights[:n], 0.9)
+	turnovers := splitTotal(rng, totals.Turnovers, mockUsageWeights[:n], 0.8)
+	fouls := splitTotal(rng, totals.PersonalFouls, mockMinuteWeights[:n], 0.6)
+	plusMinus := mockPlusMinus(rng, margin, played)
+
+	players := make([]PlayerStats, n)
+	for i, player := range roster {
+		made := twos[i] + threes[i]
+		players[i] = PlayerStats{
+			PlayerID:  player.ID,
+			Name:      player.Name,
+			Starter:   i < 5,
+			Minutes:   played[i],
+			PlusMinus: plusMinus[i],
+			Stats: TeamStats{
+				Points:                 2*twos[i] + 3*threes[i] + freeThrows[i],
+				FieldGoalsMade:         made,
+				FieldGoalsAttempted:    made + missedTwos[i] + missedThrees[i],
+				ThreePointersMade:      threes[i],
+				ThreePointersAttempted: threes[i] + missedThrees[i],
+				FreeThrowsMade:         freeThrows[i],
+				FreeThrowsAttempted:    freeThrows[i] + missedFreeThrows[i],
+				OffensiveRebounds:      offensive[i],
+				DefensiveRebounds:      defensive[i],
+				Assists:                assists[i],
+				Steals:                 steals[i],
+				Blocks:                 blocks[i],
+				Turnovers:              turnovers[i],
+				PersonalFouls:          fouls[i],
+			},
+		}
+	}
+	return players
+}
+
+// mockPlusMinus shares five times the margin out by minutes played, as five
+// players are on the floor for every point, then swings points between
+// teammates so that the plus-minus still adds up
+func mockPlusMinus(rng *rand.Rand, margin int, played []int) []int {
+	weights := make([]float64, len(played))
+	for i, minutes := range played {
+		weights[i] = float64(minutes)
+	}
+
+	total := 5 * margin
+	if total < 0 {
+		total = -total
+	}
+	plusMinus := splitTotal(rng, total, weights, 0.3)
+	for i := range plusMinus {
+		if margin < 0 {
+			plusMinus[i] = -plusMinus[i]
+		}
+	}
+	for i := range plusMinus {
+		swing := rng.Intn(9) - 4
+		plusMinus[i] += swing
+		plusMinus[(i+1)%len(plusMinus)] -= swing
+	}
+	return plusMinus
+}
+
+// splitTotal shares a total out in proportion to weights, each scaled by a
+// random factor within jitter of one, so that the shares add up to the total
+func splitTotal(rng *rand.Rand, total int, weights []float64, jitter float64) []int {
+	shares := make([]int, len(weights))
+	if total <= 0 || len(weights) == 0 {
+		return shares
+	}
+
+	jittered := make([]float64, len(weights))
+	sum := 0.0
+	for i, weight := range weights {
+		jittered[i] = weight * (1 - jitter + 2*jitter*rng.Float64())
+		sum += jittered[i]
+	}
+
+	// Round down, then hand what is left to the largest remainders
+	remainders := make([]float64, len(weights))
+	assigned := 0
+	for i := range weights {
+		exact := float64(total) * jittered[i] / sum
+		shares[i] = int(exact)
+		remainders[i] = exact - float64(shares[i])
+		assigned += shares[i]
+	}
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for i := 0; assigned < total; i++ {
+		shares[order[i%len(order)]]++
+		assigned++
+	}
+	return shares
+}
+
+// scaled multiplies a count by a factor, rounding to the nearest whole number
+func scaled(count int, factor float64) int {
+	return int(float64(count)*factor + 0.5)
+}
+
+// addMockLinescores fills in period scores for completed mock games
+func addMockLinescores(games []Game) {
+	for i := range games {
+		if games[i].IsFinal() && games[i].HomeTeam.Linescore == nil {
+			games[i].HomeTeam.Linescore, games[i].AwayTeam.Linescore = mockLinescores(games[i])
+		}
+	}
+}
+
+// mockLinescores splits both final scores into periods. In overtime games
+// the teams finish regulation level and the last overtime decides the game.
+func mockLinescores(game Game) ([]int, []int) {
+	rng := mockSource(game.Date, game.GameID, "linescore")
+	periods := game.Quarter
+	if periods < 4 {
+		periods = 4
+	}
+
+	home := make([]int, periods)
+	away := make([]int, periods)
+	homeRegulation, awayRegulation := game.HomeTeam.Score, game.AwayTeam.Score
+	if periods > 4 {
+		for p := 4; p < periods; p++ {
+			points := 6 + rng.Intn(7)
+			home[p], away[p] = points, points
+		}
+		if margin := game.HomeTeam.Score - game.AwayTeam.Score; margin > 0 {
+			home[periods-1] += margin
+		} else {
+			away[periods-1] -= margin
+		}
+		for p := 4; p < periods; p++ {
+			homeRegulation -= home[p]
+			awayRegulation -= away[p]
+		}
+	}
+
+	splitQuarters(rng, homeRegulation, home[:4])
+	splitQuarters(rng, awayRegulation, away[:4])
+	return home, away
+}
+
+// splitQuarters divides a regulation total into four quarters of similar size
+func splitQuarters(rng *rand.Rand, total int, quarters []int) {
+	remaining := total
+	for q := 0; q < 3; q++ {
+		points := total/4 + rng.Intn(9) - 4
+		if points < 0 || points > remaining {
+			points = remaining / (4 - q)
+		}
+		quarters[q] = points
+		remaining -= points
+	}
+	quarters[3] = remaining
+}
+
+// getMockPlayByPlay generates scoring plays that add up to the linescore
+func (c *Client) getMockPlayByPlay(game Game) *PlayByPlay {
+	home, away := game.HomeTeam.Linescore, game.AwayTeam.Linescore
+	if home == nil || away == nil {
+		home, away = mockLinescores(game)
+	}
+	rng := mockSource(game.Date, game.GameID, "playbyplay")
+
+	type play struct {
+		elapsed int
+		home    bool
+		points  int
+	}
+
+	codes := map[bool]string{true: game.HomeTeam.Code, false: game.AwayTeam.Code}
+	var plays []play
+	for period := 1; period <= len(home); period++ {
+		start, length := c.league.PeriodStart(period), c.league.periodLength(period)
+		for _, side := range []struct {
+			home   bool
+			points int
+		}{{true, home[period-1]}, {false, away[period-1]}} {
+			for remaining := side.points; remaining > 0; {
+				points := 2
+				switch roll := rng.Intn(100); {
+				case roll < 30:
+					points = 3
+				case roll < 45:
+					points = 1
+				}
+				if points > remaining {
+					points = remaining
+				}
+				plays = append(plays, play{elapsed: start + 1 + rng.Intn(length-1), home: side.home, points: points})
+				remaining -= points
+			}
+		}
+	}
+	sort.SliceStable(plays, func(i, j int) bool { return plays[i].elapsed < plays[j].elapsed })
+
+	pbp := &PlayByPlay{GameID: game.GameID, Events: []ScoringEvent{}}
+	homeScore, awayScore := 0, 0
+	for _, p := range plays {
+		if p.home {
+			homeScore += p.points
+		} else {
+			awayScore += p.points
+		}
+
+		period := 1
+		for period < len(home) && p.elapsed >= c.league.PeriodStart(period+1) {
+			period++
+		}
+		pbp.Events = append(pbp.Events, ScoringEvent{
+			Period:    period,
+			Clock:     FormatClock(c.league.PeriodStart(period) + c.league.periodLength(period) - p.elapsed),
+			Elapsed:   p.elapsed,
+			Team:      codes[p.home],
+			Points:    p.points,
+			HomeScore: homeScore,
+			AwayScore: awayScore,
+		})
+	}
+	return pbp
+}
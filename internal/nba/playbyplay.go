@@ -0,0 +1,69 @@
+package nba
+
+import "fmt"
+
+// PlayByPlay holds the scoring plays of a game in the order they happened
+type PlayByPlay struct {
+	GameID string         `json:"game_id"`
+	Events []ScoringEvent `json:"events"`
+}
+
+// ScoringEvent is a play that changed the score
+type ScoringEvent struct {
+	Period    int    `json:"period"`
+	Clock     string `json:"clock"`   // Time left in the period, e.g. "4:32"
+	Elapsed   int    `json:"elapsed"` // Seconds since tip-off
+	Team      string `json:"team"`
+	Points    int    `json:"points"`
+	HomeScore int    `json:"home_score"`
+	AwayScore int    `json:"away_score"`
+}
+
+// Margin returns the home team's lead after the play, negative when trailing
+func (e ScoringEvent) Margin() int {
+	return e.HomeScore - e.AwayScore
+}
+
+// GetPlayByPlay fetches the scoring plays of a completed game. Like box
+// scores, play-by-play is generated from the game's linescore.
+func (c *Client) GetPlayByPlay(game Game) (*PlayByPlay, error) {
+	if !game.IsFinal() {
+		return nil, fmt.Errorf("game %s has not finished", game.GameID)
+	}
+	return c.getMockPlayByPlay(game), nil
+}
+
+// GetPlayByPlay fetches the scoring plays of a completed game
+func (ds *DateService) GetPlayByPlay(game Game) (*PlayByPlay, error) {
+	return ds.client.GetPlayByPlay(game)
+}
+
+// periodLength returns the length of a period in seconds
+func (l League) periodLength(period int) int {
+	if period > 4 {
+		return l.OvertimeMinutes * 60
+	}
+	return l.QuarterMinutes * 60
+}
+
+// PeriodStart returns the seconds elapsed before a period begins
+func (l League) PeriodStart(period int) int {
+	elapsed := 0
+	for p := 1; p < period; p++ {
+		elapsed += l.periodLength(p)
+	}
+	return elapsed
+}
+
+// FormatClock formats seconds as minutes and seconds, e.g. "4:32"
+func FormatClock(seconds int) string {
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// PeriodClock names a moment of a game, e.g. "Q3 4:32" or "OT1 0:45"
+func PeriodClock(period int, clock string) string {
+	if period > 4 {
+		return fmt.Sprintf("OT%d %s", period-4, clock)
+	}
+	return fmt.Sprintf("Q%d %s", period, clock)
+}
@@ -0,0 +1,116 @@
+package nba
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rosterSize is the number of players who see the floor in a mock game
+const rosterSize = 10
+
+// Player is a member of a team's roster
+type Player struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Team string `json:"team"`
+}
+
+// nbaRosters lists the leading players of each NBA team, best first
+var nbaRosters = map[string][]string{
+	"ATL": {"Trae Young", "Jalen Johnson", "Bogdan Bogdanović", "De'Andre Hunter", "Clint Capela"},
+	"BOS": {"Jayson Tatum", "Jaylen Brown", "Kristaps Porziņģis", "Derrick White", "Jrue Holiday"},
+	"BKN": {"Cam Thomas", "Dennis Schröder", "Cameron Johnson", "Nic Claxton", "Ben Simmons"},
+	"CHA": {"LaMelo Ball", "Brandon Miller", "Miles Bridges", "Mark Williams", "Grant Williams"},
+	"CHI": {"Zach LaVine", "Nikola Vučević", "Coby White", "Josh Giddey", "Patrick Williams"},
+	"CLE": {"Donovan Mitchell", "Darius Garland", "Evan Mobley", "Jarrett Allen", "Max Strus"},
+	"DAL": {"Luka Dončić", "Kyrie Irving", "Klay Thompson", "P.J. Washington", "Dereck Lively II"},
+	"DEN": {"Nikola Jokić", "Jamal Murray", "Michael Porter Jr.", "Aaron Gordon", "Christian Braun"},
+	"DET": {"Cade Cunningham", "Jaden Ivey", "Jalen Duren", "Tobias Harris", "Ausar Thompson"},
+	"GSW": {"Stephen Curry", "Andrew Wiggins", "Jonathan Kuminga", "Draymond Green", "Brandin Podziemski"},
+	"HOU": {"Alperen Şengün", "Jalen Green", "Fred VanVleet", "Amen Thompson", "Dillon Brooks"},
+	"IND": {"Tyrese Haliburton", "Pascal Siakam", "Myles Turner", "Bennedict Mathurin", "Andrew Nembhard"},
+	"LAC": {"Kawhi Leonard", "James Harden", "Norman Powell", "Ivica Zubac", "Derrick Jones Jr."},
+	"LAL": {"LeBron James", "Anthony Davis", "Austin Reaves", "D'Angelo Russell", "Rui Hachimura"},
+	"MEM": {"Ja Morant", "Jaren Jackson Jr.", "Desmond Bane", "Zach Edey", "Marcus Smart"},
+	"MIA": {"Jimmy Butler", "Bam Adebayo", "Tyler Herro", "Terry Rozier", "Nikola Jović"},
+	"MIL": {"Giannis Antetokounmpo", "Damian Lillard", "Khris Middleton", "Brook Lopez", "Bobby Portis"},
+	"MIN": {"Anthony Edwards", "Julius Randle", "Rudy Gobert", "Jaden McDaniels", "Mike Conley"},
+	"NOP": {"Zion Williamson", "Brandon Ingram", "Dejounte Murray", "CJ McCollum", "Herbert Jones"},
+	"NYK": {"Jalen Brunson", "Karl-Anthony Towns", "Mikal Bridges", "OG Anunoby", "Josh Hart"},
+	"OKC": {"Shai Gilgeous-Alexander", "Jalen Williams", "Chet Holmgren", "Luguentz Dort", "Isaiah Hartenstein"},
+	"ORL": {"Paolo Banchero", "Franz Wagner", "Jalen Suggs", "Wendell Carter Jr.", "Kentavious Caldwell-Pope"},
+	"PHI": {"Joel Embiid", "Tyrese Maxey", "Paul George", "Kelly Oubre Jr.", "Caleb Martin"},
+	"PHX": {"Kevin Durant", "Devin Booker", "Bradley Beal", "Jusuf Nurkić", "Grayson Allen"},
+	"POR": {"Anfernee Simons", "Jerami Grant", "Deandre Ayton", "Shaedon Sharpe", "Scoot Henderson"},
+	"SAC": {"De'Aaron Fox", "Domantas Sabonis", "DeMar DeRozan", "Keegan Murray", "Malik Monk"},
+	"SAS": {"Victor Wembanyama", "Devin Vassell", "Jeremy Sochan", "Keldon Johnson", "Chris Paul"},
+	"TOR": {"Scottie Barnes", "RJ Barrett", "Immanuel Quickley", "Jakob Pöltl", "Gradey Dick"},
+	"UTA": {"Lauri Markkanen", "Collin Sexton", "Jordan Clarkson", "John Collins", "Walker Kessler"},
+	"WAS": {"Jordan Poole", "Kyle Kuzma", "Jonas Valančiūnas", "Bilal Coulibaly", "Alexandre Sarr"},
+}
+
+// wnbaRosters lists the leading players of each WNBA team, best first
+var wnbaRosters = map[string][]string{
+	"ATL": {"Rhyne Howard", "Allisha Gray", "Tina Charles"},
+	"CHI": {"Angel Reese", "Chennedy Carter", "Marina Mabrey"},
+	"CON": {"Alyssa Thomas", "DeWanna Bonner", "Brionna Jones"},
+	"IND": {"Caitlin Clark", "Kelsey Mitchell", "Aliyah Boston"},
+	"NYL": {"Breanna Stewart", "Sabrina Ionescu", "Jonquel Jones"},
+	"WAS": {"Ariel Atkins", "Brittney Sykes", "Shakira Austin"},
+	"DAL": {"Arike Ogunbowale", "Satou Sabally", "Teaira McCowan"},
+	"GSV": {"Kayla Thornton", "Tiffany Hayes", "Veronica Burton"},
+	"LAS": {"Dearica Hamby", "Rickea Jackson", "Cameron Brink"},
+	"LVA": {"A'ja Wilson", "Jackie Young", "Chelsea Gray"},
+	"MIN": {"Napheesa Collier", "Kayla McBride", "Courtney Williams"},
+	"PHO": {"Kahleah Copper", "Diana Taurasi", "Brittney Griner"},
+	"SEA": {"Jewell Loyd", "Nneka Ogwumike", "Skylar Diggins-Smith"},
+}
+
+// Names that fill out rosters beyond the leading players
+var (
+	mockFirstNames = []string{
+		"Jordan", "Marcus", "Tyler", "Devin", "Isaiah", "Malik", "Trey", "Jalen", "Cole", "Andre",
+		"Keon", "Darius", "Nate", "Elijah", "Caleb", "Xavier", "Jamal", "Quincy", "Omar", "Luka",
+	}
+	mockWNBAFirstNames = []string{
+		"Aaliyah", "Brianna", "Chloe", "Destiny", "Jasmine", "Kiara", "Layla", "Maya", "Nia", "Sasha",
+		"Taylor", "Zoe", "Imani", "Jada", "Kendall", "Morgan", "Paige", "Riley", "Sydney", "Tiana",
+	}
+	mockLastNames = []string{
+		"Anderson", "Brooks", "Carter", "Dawson", "Ellis", "Fleming", "Grant", "Harper", "Ingram", "Jenkins",
+		"Kovač", "Lawson", "Mitchell", "Núñez", "Owens", "Porter", "Reyes", "Sanders", "Turner", "Walsh",
+	}
+)
+
+// Roster returns the players of a team: its leading players, then mock
+// players up to a full rotation. Rosters are the same on every call.
+func Roster(league League, team string) []Player {
+	team = strings.ToUpper(team)
+	rosters, firstNames := nbaRosters, mockFirstNames
+	switch league.ID {
+	case WNBA.ID:
+		rosters, firstNames = wnbaRosters, mockWNBAFirstNames
+	case GLeague.ID:
+		rosters = nil
+	}
+
+	names := append([]string(nil), rosters[team]...)
+	rng := mockSource(league.Code, team, "roster")
+	taken := make(map[string]bool)
+	for _, name := range names {
+		taken[name] = true
+	}
+	for len(names) < rosterSize {
+		name := firstNames[rng.Intn(len(firstNames))] + " " + mockLastNames[rng.Intn(len(mockLastNames))]
+		if !taken[name] {
+			taken[name] = true
+			names = append(names, name)
+		}
+	}
+
+	roster := make([]Player, len(names))
+	for i, name := range names {
+		roster[i] = Player{ID: fmt.Sprintf("%s-%02d", team, i+1), Name: name, Team: team}
+	}
+	return roster
+}
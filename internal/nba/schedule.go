@@ -0,0 +1,55 @@
+package nba
+
+import "fmt"
+
+// GetRegularSeason fetches every regular season game of a season (e.g.,
+// "2023-24"), split into completed games and games still to be played
+func (ds *DateService) GetRegularSeason(season string) ([]Game, []Game, error) {
+	league := ds.League()
+	start, _, err := league.SeasonDates(season)
+	if err != nil {
+		return nil, nil, err
+	}
+	playoffStart, err := league.PlayoffStart(season)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	games, err := ds.GetScheduleBetween(start.Format("2006-01-02"), playoffStart.AddDate(0, 0, -1).Format("2006-01-02"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	played, remaining := []Game{}, []Game{}
+	for _, game := range games {
+		if game.IsFinal() {
+			played = append(played, game)
+		} else {
+			remaining = append(remaining, game)
+		}
+	}
+
+	return played, remaining, nil
+}
+
+// GetScheduleBetween fetches every game between two dates, including games
+// still to be played. Like GetGamesBetween it allows up to a season's worth
+// of days, but it does not stop at today.
+func (ds *DateService) GetScheduleBetween(startDateStr, endDateStr string) ([]Game, error) {
+	startDate, endDate, err := parseRange(startDateStr, endDateStr, maxSeasonDays)
+	if err != nil {
+		return nil, err
+	}
+
+	games := []Game{}
+	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
+		dateStr := currentDate.Format("2006-01-02")
+		result, err := ds.GetScheduleByDate(dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get games for %s: %w", dateStr, err)
+		}
+		games = append(games, result.Games...)
+	}
+
+	return games, nil
+}
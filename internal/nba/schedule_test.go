@@ -0,0 +1,45 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRegularSeason(t *testing.T) {
+	dateService := NewDateService(NewClient())
+
+	played, remaining, err := dateService.GetRegularSeason("2022-23")
+	require.NoError(t, err)
+	assert.NotEmpty(t, played)
+	assert.Empty(t, remaining)
+	for _, game := range played {
+		assert.True(t, game.IsFinal())
+		assert.Less(t, game.Date, "2023-04-15", "playoff games are excluded")
+	}
+
+	played, remaining, err = dateService.GetRegularSeason("2099-00")
+	require.NoError(t, err)
+	assert.Empty(t, played)
+	require.NotEmpty(t, remaining)
+	assert.Equal(t, "Scheduled", remaining[0].Status)
+
+	_, _, err = dateService.GetRegularSeason("bad")
+	assert.Error(t, err)
+}
+
+func TestGetScheduleBetween(t *testing.T) {
+	dateService := NewDateService(NewClient())
+
+	games, err := dateService.GetScheduleBetween("2099-01-01", "2099-01-02")
+	require.NoError(t, err)
+	require.Len(t, games, 6, "future dates are included")
+	assert.Equal(t, "2099-01-01", games[0].Date)
+	assert.Equal(t, "Scheduled", games[5].Status)
+
+	_, err = dateService.GetScheduleBetween("2099-01-02", "2099-01-01")
+	assert.Error(t, err)
+	_, err = dateService.GetScheduleBetween("2022-01-01", "2023-06-01")
+	assert.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package nba
+
+// SpoilerFreeResults are game results with every score, winner and margin
+// left out, for people catching up on recorded games
+type SpoilerFreeResults struct {
+	Date        string            `json:"date"`
+	League      string            `json:"league,omitempty"`
+	SpoilerFree bool              `json:"spoiler_free"`
+	Games       []SpoilerFreeGame `json:"games"`
+	TotalGames  int               `json:"total_games"`
+	Summary     GameSummary       `json:"summary"`
+	Metadata    ResultMetadata    `json:"metadata"`
+}
+
+// SpoilerFreeGame is a game without its score. The quarter is left out too,
+// since a fifth period gives away a close finish.
+type SpoilerFreeGame struct {
+	GameID       string      `json:"game_id"`
+	Date         string      `json:"date"`
+	Time         string      `json:"time"`
+	HomeTeam     string      `json:"home_team"`
+	AwayTeam     string      `json:"away_team"`
+	Status       string      `json:"status"`
+	Prediction   *Prediction `json:"prediction,omitempty"`
+	Watchability *float64    `json:"watchability,omitempty"` // Watchability score only
+}
+
+// SpoilerFree returns the results without scores, winners, margins or
+// highlights, keeping each game's status and watchability
+func (r *GameResults) SpoilerFree() *SpoilerFreeResults {
+	summary := r.Summary
+	summary.Highlights = nil
+
+	return &SpoilerFreeResults{
+		Date:        r.Date,
+		League:      r.League,
+		SpoilerFree: true,
+		Games:       SpoilerFreeGames(r.Games),
+		TotalGames:  r.TotalGames,
+		Summary:     summary,
+		Metadata:    r.Metadata,
+	}
+}
+
+// SpoilerFreeGames converts games to their spoiler-free form
+func SpoilerFreeGames(games []Game) []SpoilerFreeGame {
+	spoilerFree := make([]SpoilerFreeGame, 0, len(games))
+	for _, game := range games {
+		var watchability *float64
+		if game.Watchability != nil {
+			score := game.Watchability.Score
+			watchability = &score
+		}
+		spoilerFree = append(spoilerFree, SpoilerFreeGame{
+			GameID:       game.GameID,
+			Date:         game.Date,
+			Time:         game.Time,
+			HomeTeam:     game.HomeTeam.Name,
+			AwayTeam:     game.AwayTeam.Name,
+			Status:       game.Status,
+			Prediction:   game.Prediction,
+			Watchability: watchability,
+		})
+	}
+	return spoilerFree
+}
@@ -0,0 +1,46 @@
+package nba
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpoilerFree(t *testing.T) {
+	result := &GameResults{
+		Date: "2024-01-15",
+		Games: []Game{
+			{
+				GameID:       "1",
+				HomeTeam:     Team{Code: "LAL", Name: "Los Angeles Lakers", Score: 112, Linescore: []int{30, 28, 27, 27}},
+				AwayTeam:     Team{Code: "BOS", Name: "Boston Celtics", Score: 108, Linescore: []int{25, 30, 26, 27}},
+				Status:       "Final",
+				Quarter:      4,
+				Watchability: &Watchability{Score: 72.5, FinalMargin: 4, LeadChanges: 9, Source: "play_by_play"},
+			},
+			{GameID: "2", HomeTeam: Team{Name: "Miami Heat"}, AwayTeam: Team{Name: "Golden State Warriors"}, Status: "Scheduled"},
+		},
+		TotalGames: 2,
+		Summary:    GameSummary{Final: 1, Scheduled: 1, Highlights: []string{"LAL won their 5th straight"}},
+	}
+
+	spoilerFree := result.SpoilerFree()
+
+	assert.True(t, spoilerFree.SpoilerFree)
+	assert.Empty(t, spoilerFree.Summary.Highlights)
+	assert.Equal(t, 1, spoilerFree.Summary.Final)
+	require.Len(t, spoilerFree.Games, 2)
+	assert.Equal(t, "Final", spoilerFree.Games[0].Status)
+	assert.Equal(t, "Los Angeles Lakers", spoilerFree.Games[0].HomeTeam)
+	require.NotNil(t, spoilerFree.Games[0].Watchability)
+	assert.Equal(t, 72.5, *spoilerFree.Games[0].Watchability)
+	assert.Nil(t, spoilerFree.Games[1].Watchability)
+	assert.Len(t, result.Summary.Highlights, 1, "original results are unchanged")
+
+	data, err := json.Marshal(spoilerFree)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "score")
+	assert.NotContains(t, string(data), "112")
+}
@@ -0,0 +1,119 @@
+package nba
+
+import "strings"
+
+// TeamInfo describes a franchise in a league's team registry
+type TeamInfo struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	City       string `json:"city"`
+	Nickname   string `json:"nickname"`
+	Conference string `json:"conference,omitempty"`
+	Division   string `json:"division,omitempty"`
+}
+
+var nbaTeams = []TeamInfo{
+	{Code: "BOS", Name: "Boston Celtics", City: "Boston", Nickname: "Celtics", Conference: "East", Division: "Atlantic"},
+	{Code: "BKN", Name: "Brooklyn Nets", City: "Brooklyn", Nickname: "Nets", Conference: "East", Division: "Atlantic"},
+	{Code: "NYK", Name: "New York Knicks", City: "New York", Nickname: "Knicks", Conference: "East", Division: "Atlantic"},
+	{Code: "PHI", Name: "Philadelphia 76ers", City: "Philadelphia", Nickname: "76ers", Conference: "East", Division: "Atlantic"},
+	{Code: "TOR", Name: "Toronto Raptors", City: "Toronto", Nickname: "Raptors", Conference: "East", Division: "Atlantic"},
+	{Code: "CHI", Name: "Chicago Bulls", City: "Chicago", Nickname: "Bulls", Conference: "East", Division: "Central"},
+	{Code: "CLE", Name: "Cleveland Cavaliers", City: "Cleveland", Nickname: "Cavaliers", Conference: "East", Division: "Central"},
+	{Code: "DET", Name: "Detroit Pistons", City: "Detroit", Nickname: "Pistons", Conference: "East", Division: "Central"},
+	{Code: "IND", Name: "Indiana Pacers", City: "Indiana", Nickname: "Pacers", Conference: "East", Division: "Central"},
+	{Code: "MIL", Name: "Milwaukee Bucks", City: "Milwaukee", Nickname: "Bucks", Conference: "East", Division: "Central"},
+	{Code: "ATL", Name: "Atlanta Hawks", City: "Atlanta", Nickname: "Hawks", Conference: "East", Division: "Southeast"},
+	{Code: "CHA", Name: "Charlotte Hornets", City: "Charlotte", Nickname: "Hornets", Conference: "East", Division: "Southeast"},
+	{Code: "MIA", Name: "Miami Heat", City: "Miami", Nickname: "Heat", Conference: "East", Division: "Southeast"},
+	{Code: "ORL", Name: "Orlando Magic", City: "Orlando", Nickname: "Magic", Conference: "East", Division: "Southeast"},
+	{Code: "WAS", Name: "Washington Wizards", City: "Washington", Nickname: "Wizards", Conference: "East", Division: "Southeast"},
+	{Code: "DEN", Name: "Denver Nuggets", City: "Denver", Nickname: "Nuggets", Conference: "West", Division: "Northwest"},
+	{Code: "MIN", Name: "Minnesota Timberwolves", City: "Minnesota", Nickname: "Timberwolves", Conference: "West", Division: "Northwest"},
+	{Code: "OKC", Name: "Oklahoma City Thunder", City: "Oklahoma City", Nickname: "Thunder", Conference: "West", Division: "Northwest"},
+	{Code: "POR", Name: "Portland Trail Blazers", City: "Portland", Nickname: "Trail Blazers", Conference: "West", Division: "Northwest"},
+	{Code: "UTA", Name: "Utah Jazz", City: "Utah", Nickname: "Jazz", Conference: "West", Division: "Northwest"},
+	{Code: "GSW", Name: "Golden State Warriors", City: "Golden State", Nickname: "Warriors", Conference: "West", Division: "Pacific"},
+	{Code: "LAC", Name: "LA Clippers", City: "LA", Nickname: "Clippers", Conference: "West", Division: "Pacific"},
+	{Code: "LAL", Name: "Los Angeles Lakers", City: "Los Angeles", Nickname: "Lakers", Conference: "West", Division: "Pacific"},
+	{Code: "PHX", Name: "Phoenix Suns", City: "Phoenix", Nickname: "Suns", Conference: "West", Division: "Pacific"},
+	{Code: "SAC", Name: "Sacramento Kings", City: "Sacramento", Nickname: "Kings", Conference: "West", Division: "Pacific"},
+	{Code: "DAL", Name: "Dallas Mavericks", City: "Dallas", Nickname: "Mavericks", Conference: "West", Division: "Southwest"},
+	{Code: "HOU", Name: "Houston Rockets", City: "Houston", Nickname: "Rockets", Conference: "West", Division: "Southwest"},
+	{Code: "MEM", Name: "Memphis Grizzlies", City: "Memphis", Nickname: "Grizzlies", Conference: "West", Division: "Southwest"},
+	{Code: "NOP", Name: "New Orleans Pelicans", City: "New Orleans", Nickname: "Pelicans", Conference: "West", Division: "Southwest"},
+	{Code: "SAS", Name: "San Antonio Spurs", City: "San Antonio", Nickname: "Spurs", Conference: "West", Division: "Southwest"},
+}
+
+// The WNBA has no divisions; teams are grouped by conference only
+var wnbaTeams = []TeamInfo{
+	{Code: "ATL", Name: "Atlanta Dream", City: "Atlanta", Nickname: "Dream", Conference: "East"},
+	{Code: "CHI", Name: "Chicago Sky", City: "Chicago", Nickname: "Sky", Conference: "East"},
+	{Code: "CON", Name: "Connecticut Sun", City: "Connecticut", Nickname: "Sun", Conference: "East"},
+	{Code: "IND", Name: "Indiana Fever", City: "Indiana", Nickname: "Fever", Conference: "East"},
+	{Code: "NYL", Name: "New York Liberty", City: "New York", Nickname: "Liberty", Conference: "East"},
+	{Code: "WAS", Name: "Washington Mystics", City: "Washington", Nickname: "Mystics", Conference: "East"},
+	{Code: "DAL", Name: "Dallas Wings", City: "Dallas", Nickname: "Wings", Conference: "West"},
+	{Code: "GSV", Name: "Golden State Valkyries", City: "Golden State", Nickname: "Valkyries", Conference: "West"},
+	{Code: "LAS", Name: "Los Angeles Sparks", City: "Los Angeles", Nickname: "Sparks", Conference: "West"},
+	{Code: "LVA", Name: "Las Vegas Aces", City: "Las Vegas", Nickname: "Aces", Conference: "West"},
+	{Code: "MIN", Name: "Minnesota Lynx", City: "Minnesota", Nickname: "Lynx", Conference: "West"},
+	{Code: "PHO", Name: "Phoenix Mercury", City: "Phoenix", Nickname: "Mercury", Conference: "West"},
+	{Code: "SEA", Name: "Seattle Storm", City: "Seattle", Nickname: "Storm", Conference: "West"},
+}
+
+var gLeagueTeams = []TeamInfo{
+	{Code: "CCG", Name: "Capital City Go-Go", City: "Capital City", Nickname: "Go-Go", Conference: "East"},
+	{Code: "CLC", Name: "Cleveland Charge", City: "Cleveland", Nickname: "Charge", Conference: "East"},
+	{Code: "CPS", Name: "College Park Skyhawks", City: "College Park", Nickname: "Skyhawks", Conference: "East"},
+	{Code: "DEL", Name: "Delaware Blue Coats", City: "Delaware", Nickname: "Blue Coats", Conference: "East"},
+	{Code: "GBO", Name: "Greensboro Swarm", City: "Greensboro", Nickname: "Swarm", Conference: "East"},
+	{Code: "GRG", Name: "Grand Rapids Gold", City: "Grand Rapids", Nickname: "Gold", Conference: "East"},
+	{Code: "LIN", Name: "Long Island Nets", City: "Long Island", Nickname: "Nets", Conference: "East"},
+	{Code: "MCC", Name: "Motor City Cruise", City: "Motor City", Nickname: "Cruise", Conference: "East"},
+	{Code: "MNE", Name: "Maine Celtics", City: "Maine", Nickname: "Celtics", Conference: "East"},
+	{Code: "NOB", Name: "Noblesville Boom", City: "Noblesville", Nickname: "Boom", Conference: "East"},
+	{Code: "OSC", Name: "Osceola Magic", City: "Osceola", Nickname: "Magic", Conference: "East"},
+	{Code: "RAP", Name: "Raptors 905", City: "Mississauga", Nickname: "Raptors 905", Conference: "East"},
+	{Code: "WCB", Name: "Windy City Bulls", City: "Windy City", Nickname: "Bulls", Conference: "East"},
+	{Code: "WES", Name: "Westchester Knicks", City: "Westchester", Nickname: "Knicks", Conference: "East"},
+	{Code: "WIS", Name: "Wisconsin Herd", City: "Wisconsin", Nickname: "Herd", Conference: "East"},
+	{Code: "AUS", Name: "Austin Spurs", City: "Austin", Nickname: "Spurs", Conference: "West"},
+	{Code: "BIR", Name: "Birmingham Squadron", City: "Birmingham", Nickname: "Squadron", Conference: "West"},
+	{Code: "IWA", Name: "Iowa Wolves", City: "Iowa", Nickname: "Wolves", Conference: "West"},
+	{Code: "MEM", Name: "Memphis Hustle", City: "Memphis", Nickname: "Hustle", Conference: "West"},
+	{Code: "MXC", Name: "Mexico City Capitanes", City: "Mexico City", Nickname: "Capitanes", Conference: "West"},
+	{Code: "OKC", Name: "Oklahoma City Blue", City: "Oklahoma City", Nickname: "Blue", Conference: "West"},
+	{Code: "RCR", Name: "Rip City Remix", City: "Rip City", Nickname: "Remix", Conference: "West"},
+	{Code: "RGV", Name: "Rio Grande Valley Vipers", City: "Rio Grande Valley", Nickname: "Vipers", Conference: "West"},
+	{Code: "SBL", Name: "South Bay Lakers", City: "South Bay", Nickname: "Lakers", Conference: "West"},
+	{Code: "SCW", Name: "Santa Cruz Warriors", City: "Santa Cruz", Nickname: "Warriors", Conference: "West"},
+	{Code: "SDC", Name: "San Diego Clippers", City: "San Diego", Nickname: "Clippers", Conference: "West"},
+	{Code: "SLC", Name: "Salt Lake City Stars", City: "Salt Lake City", Nickname: "Stars", Conference: "West"},
+	{Code: "STO", Name: "Stockton Kings", City: "Stockton", Nickname: "Kings", Conference: "West"},
+	{Code: "SXF", Name: "Sioux Falls Skyforce", City: "Sioux Falls", Nickname: "Skyforce", Conference: "West"},
+	{Code: "TEX", Name: "Texas Legends", City: "Texas", Nickname: "Legends", Conference: "West"},
+	{Code: "VAL", Name: "Valley Suns", City: "Valley", Nickname: "Suns", Conference: "West"},
+}
+
+// Teams returns the team registry for a league
+func Teams(league League) []TeamInfo {
+	switch league.ID {
+	case WNBA.ID:
+		return wnbaTeams
+	case GLeague.ID:
+		return gLeagueTeams
+	default:
+		return nbaTeams
+	}
+}
+
+// LookupTeam finds a team in a league's registry by its code
+func LookupTeam(league League, code string) (TeamInfo, bool) {
+	for _, team := range Teams(league) {
+		if strings.EqualFold(team.Code, code) {
+			return team, true
+		}
+	}
+	return TeamInfo{}, false
+}
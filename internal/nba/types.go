@@ -1,5 +1,10 @@
 package nba
 
+import (
+	"sort"
+	"strings"
+)
+
 // Game represents an NBA game
 type Game struct {
 	GameID   string `json:"game_id"`
@@ -10,6 +15,13 @@ type Game struct {
 	Status   string `json:"status"` // "Scheduled", "Live", "Final"
 	Quarter  int    `json:"quarter"`
 	TimeLeft string `json:"time_left"`
+
+	Prediction   *Prediction   `json:"prediction,omitempty"`   // Pre-game forecast, set for scheduled games
+	Watchability *Watchability `json:"watchability,omitempty"` // Excitement rating, set for final games
+	Fatigue      *Fatigue      `json:"fatigue,omitempty"`      // Rest and travel of both teams going into the game
+	Feats        []Feat        `json:"feats,omitempty"`        // Notable individual performances, set for final games
+	Odds         *Odds         `json:"odds,omitempty"`         // Closing lines from an odds file, settled once final
+	Flow         *Flow         `json:"flow,omitempty"`         // Leads, ties and runs from play-by-play, set for final games
 }
 
 // Team represents an NBA team
@@ -17,6 +29,118 @@ type Team struct {
 	Name  string `json:"name"`
 	Code  string `json:"code"`
 	Score int    `json:"score"`
+
+	Linescore []int `json:"linescore,omitempty"` // Points scored in each period, overtimes included
+}
+
+// Prediction is a pre-game forecast of a game's outcome
+type Prediction struct {
+	HomeWinProbability float64 `json:"home_win_probability"`
+	AwayWinProbability float64 `json:"away_win_probability"`
+	ExpectedMargin     float64 `json:"expected_margin"` // Home points minus away points
+	PredictedWinner    string  `json:"predicted_winner"`
+	Model              string  `json:"model"`
+}
+
+// Watchability rates how exciting a completed game was to watch, from 0 to 100
+type Watchability struct {
+	Score       float64 `json:"score"`
+	FinalMargin int     `json:"final_margin"`
+	Overtimes   int     `json:"overtimes"`
+	LeadChanges int     `json:"lead_changes"`
+	Ties        int     `json:"ties"`
+	LateMargin  int     `json:"late_margin"` // Margin entering crunch time
+	Source      string  `json:"source"`      // "play-by-play" or "linescore"
+}
+
+// Fatigue describes how rested both teams were going into a game
+type Fatigue struct {
+	Home TeamFatigue `json:"home"`
+	Away TeamFatigue `json:"away"`
+}
+
+// TeamFatigue is one team's schedule load and travel before a game
+type TeamFatigue struct {
+	RestDays    int     `json:"rest_days"` // Full days off since the previous game; -1 when there is none
+	BackToBack  bool    `json:"back_to_back"`
+	ThreeInFour bool    `json:"three_in_four"` // Third game in four days
+	FourInSix   bool    `json:"four_in_six"`   // Fourth game in six days
+	TravelMiles float64 `json:"travel_miles"`  // From the previous game's arena, or from home
+	TimeZones   int     `json:"time_zones"`    // Time zones crossed to get there
+}
+
+// Flags lists the schedule flags that apply, e.g. "B2B, 3-in-4"
+func (f TeamFatigue) Flags() string {
+	var flags []string
+	if f.BackToBack {
+		flags = append(flags, "B2B")
+	}
+	if f.ThreeInFour {
+		flags = append(flags, "3-in-4")
+	}
+	if f.FourInSix {
+		flags = append(flags, "4-in-6")
+	}
+	return strings.Join(flags, ", ")
+}
+
+// Odds are a game's closing betting lines and, once it is final, how they
+// settled. Spread and Total are nil when the odds file has no such line.
+type Odds struct {
+	Spread        *float64 `json:"spread,omitempty"`         // Home team's line, negative when favoured
+	Total         *float64 `json:"total,omitempty"`          // Over/under on combined points
+	HomeMoneyline int      `json:"home_moneyline,omitempty"` // American odds, e.g. -150 or +130
+	AwayMoneyline int      `json:"away_moneyline,omitempty"`
+
+	Cover     string   `json:"cover,omitempty"`      // Code of the team that covered, or "Push"
+	ATSMargin *float64 `json:"ats_margin,omitempty"` // Home margin plus the spread, once settled; positive when home covers
+	OverUnder string   `json:"over_under,omitempty"` // "Over", "Under" or "Push"
+}
+
+// Feat is a notable individual performance, such as a triple-double or a
+// 40-point game
+type Feat struct {
+	Tag         string `json:"tag"`   // e.g. "triple_double", "40_points"
+	Label       string `json:"label"` // e.g. "Triple-double"
+	Date        string `json:"date"`
+	GameID      string `json:"game_id"`
+	PlayerID    string `json:"player_id"`
+	Name        string `json:"name"`
+	Team        string `json:"team"`
+	Opponent    string `json:"opponent"`
+	Line        string `json:"line"`         // e.g. "31 PTS, 12 REB, 10 AST"
+	SeasonCount int    `json:"season_count"` // The player's feats with this tag so far this season, this one included
+	Description string `json:"description"`
+}
+
+// Flow describes how the lead went back and forth over a game
+type Flow struct {
+	LeadChanges        int        `json:"lead_changes"`
+	TimesTied          int        `json:"times_tied"`
+	HomeLargestLead    int        `json:"home_largest_lead"`
+	AwayLargestLead    int        `json:"away_largest_lead"`
+	HomeSecondsLeading int        `json:"home_seconds_leading"`
+	AwaySecondsLeading int        `json:"away_seconds_leading"`
+	SecondsTied        int        `json:"seconds_tied"`
+	HomeLongestRun     ScoringRun `json:"home_longest_run"`
+	AwayLongestRun     ScoringRun `json:"away_longest_run"`
+	Comeback           *Comeback  `json:"comeback,omitempty"` // Set when the winner trailed
+}
+
+// ScoringRun is a stretch of unanswered points by one team
+type ScoringRun struct {
+	Team   string `json:"team"`
+	Points int    `json:"points"`
+	Period int    `json:"period"` // When the run started
+	Clock  string `json:"clock"`
+}
+
+// Comeback is the largest deficit a winning team came back from
+type Comeback struct {
+	Team    string `json:"team"`
+	Deficit int    `json:"deficit"`
+	Period  int    `json:"period"` // When the deficit was largest
+	Clock   string `json:"clock"`
 }
 
 // NBAAPIResponse represents the structure from NBA's API
@@ -39,4 +163,58 @@ type NBAAPIResponse struct {
 			} `json:"awayTeam"`
 		} `json:"games"`
 	} `json:"scoreboard"`
-}
\ No newline at end of file
+}
+
+// IsFinal reports whether the game has finished
+func (g Game) IsFinal() bool {
+	return g.Status == "Final"
+}
+
+// HasTeam reports whether the team with the given code plays in the game
+func (g Game) HasTeam(code string) bool {
+	return strings.EqualFold(g.HomeTeam.Code, code) || strings.EqualFold(g.AwayTeam.Code, code)
+}
+
+// IsHome reports whether the team with the given code is the home team
+func (g Game) IsHome(code string) bool {
+	return strings.EqualFold(g.HomeTeam.Code, code)
+}
+
+// TeamAndOpponent returns the given team and its opponent in the game
+func (g Game) TeamAndOpponent(code string) (Team, Team) {
+	if g.IsHome(code) {
+		return g.HomeTeam, g.AwayTeam
+	}
+	return g.AwayTeam, g.HomeTeam
+}
+
+// Winner returns the winning team of a final game. It reports false when
+// the game is not final or is tied.
+func (g Game) Winner() (Team, bool) {
+	if !g.IsFinal() || g.HomeTeam.Score == g.AwayTeam.Score {
+		return Team{}, false
+	}
+	if g.HomeTeam.Score > g.AwayTeam.Score {
+		return g.HomeTeam, true
+	}
+	return g.AwayTeam, true
+}
+
+// Margin returns the absolute points difference between the two teams
+func (g Game) Margin() int {
+	margin := g.HomeTeam.Score - g.AwayTeam.Score
+	if margin < 0 {
+		return -margin
+	}
+	return margin
+}
+
+// SortGames orders games chronologically by date and tip-off time
+func SortGames(games []Game) {
+	sort.SliceStable(games, func(i, j int) bool {
+		if games[i].Date != games[j].Date {
+			return games[i].Date < games[j].Date
+		}
+		return games[i].Time < games[j].Time
+	})
+}
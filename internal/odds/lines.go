@@ -0,0 +1,207 @@
+package odds
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Line is one game's closing lines from an odds file. A line names its game
+// by ID, or by date and the home and away team codes.
+type Line struct {
+	GameID        string   `json:"game_id,omitempty"`
+	Date          string   `json:"date,omitempty"`
+	Home          string   `json:"home,omitempty"`
+	Away          string   `json:"away,omitempty"`
+	Spread        *float64 `json:"spread,omitempty"` // Home team's line, negative when favoured
+	Total         *float64 `json:"total,omitempty"`
+	HomeMoneyline int      `json:"home_moneyline,omitempty"`
+	AwayMoneyline int      `json:"away_moneyline,omitempty"`
+}
+
+// csvColumns are the CSV headers a line is read from; other columns are ignored
+var csvColumns = []string{"game_id", "date", "home", "away", "spread", "total", "home_moneyline", "away_moneyline"}
+
+// Load reads closing lines from a CSV or JSON file, chosen by its extension
+func Load(path string) ([]Line, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading odds: %w", err)
+	}
+	defer file.Close()
+
+	var lines []Line
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		lines, err = ReadCSV(file)
+	case ".json":
+		lines, err = ReadJSON(file)
+	default:
+		return nil, fmt.Errorf("unsupported odds file '%s': use .csv or .json", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing odds %s: %w", path, err)
+	}
+	return lines, nil
+}
+
+// ReadJSON reads a JSON array of lines
+func ReadJSON(r io.Reader) ([]Line, error) {
+	var lines []Line
+	if err := json.NewDecoder(r).Decode(&lines); err != nil {
+		return nil, err
+	}
+	for i := range lines {
+		if err := lines[i].normalize(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	return lines, nil
+}
+
+// ReadCSV reads lines from CSV with a header row. Spreads may be written
+// "PK" for a pick'em, and empty cells leave a line out.
+func ReadCSV(r io.Reader) ([]Line, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no header row")
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	known := false
+	for _, column := range csvColumns {
+		if _, ok := columns[column]; ok {
+			known = true
+		}
+	}
+	if !known {
+		return nil, fmt.Errorf("header has none of the columns %s", strings.Join(csvColumns, ", "))
+	}
+
+	var lines []Line
+	for row, record := range records[1:] {
+		cell := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		line := Line{GameID: cell("game_id"), Date: cell("date"), Home: cell("home"), Away: cell("away")}
+		if line.Spread, err = parseLine(cell("spread")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid spread: %w", row+2, err)
+		}
+		if line.Total, err = parseLine(cell("total")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid total: %w", row+2, err)
+		}
+		if line.HomeMoneyline, err = parseMoneyline(cell("home_moneyline")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid home moneyline: %w", row+2, err)
+		}
+		if line.AwayMoneyline, err = parseMoneyline(cell("away_moneyline")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid away moneyline: %w", row+2, err)
+		}
+		if err := line.normalize(); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row+2, err)
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// normalize upper-cases team codes and checks the line names its game
+func (l *Line) normalize() error {
+	l.Home, l.Away = strings.ToUpper(l.Home), strings.ToUpper(l.Away)
+	if l.GameID == "" && (l.Date == "" || l.Home == "" || l.Away == "") {
+		return fmt.Errorf("needs a game_id, or a date with home and away teams")
+	}
+	return nil
+}
+
+// parseLine parses a spread or total, nil when empty
+func parseLine(value string) (*float64, error) {
+	switch strings.ToLower(value) {
+	case "":
+		return nil, nil
+	case "pk", "pick":
+		zero := 0.0
+		return &zero, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a number", value)
+	}
+	return &number, nil
+}
+
+// parseMoneyline parses American odds such as -150 or +130, zero when empty
+func parseMoneyline(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	moneyline, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a whole number", value)
+	}
+	return moneyline, nil
+}
+
+// Apply joins lines to games by game ID, or by date and teams, settling the
+// lines of final games. A line with both a game ID and a date only joins a
+// game on that date. It returns how many games got a line.
+func Apply(games []nba.Game, lines []Line) int {
+	byID := make(map[string]Line)
+	byMatchup := make(map[string]Line)
+	for _, line := range lines {
+		if line.GameID != "" {
+			byID[line.GameID+"|"+line.Date] = line
+		}
+		if line.Date != "" && line.Home != "" && line.Away != "" {
+			byMatchup[matchupKey(line.Date, line.Home, line.Away)] = line
+		}
+	}
+
+	matched := 0
+	for i := range games {
+		game := &games[i]
+		line, ok := byID[game.GameID+"|"+game.Date]
+		if !ok {
+			line, ok = byID[game.GameID+"|"]
+		}
+		if !ok {
+			line, ok = byMatchup[matchupKey(game.Date, game.HomeTeam.Code, game.AwayTeam.Code)]
+		}
+		if !ok {
+			continue
+		}
+		game.Odds = &nba.Odds{
+			Spread:        line.Spread,
+			Total:         line.Total,
+			HomeMoneyline: line.HomeMoneyline,
+			AwayMoneyline: line.AwayMoneyline,
+		}
+		Settle(game)
+		matched++
+	}
+	return matched
+}
+
+func matchupKey(date, home, away string) string {
+	return date + "|" + strings.ToUpper(home) + "|" + strings.ToUpper(away)
+}
@@ -0,0 +1,111 @@
+package odds
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCSV(t *testing.T) {
+	lines, err := ReadCSV(strings.NewReader(`Date,Home,Away,Spread,Total,Home_Moneyline,Away_Moneyline,Book
+2024-01-15,lal,bos,+3.5,228.5,+140,-165,Feed
+2024-01-15,GSW,MIA,PK,,,,Feed
+`))
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "LAL", lines[0].Home, "codes upper-cased")
+	assert.Equal(t, 3.5, *lines[0].Spread)
+	assert.Equal(t, 228.5, *lines[0].Total)
+	assert.Equal(t, 140, lines[0].HomeMoneyline)
+	assert.Equal(t, -165, lines[0].AwayMoneyline)
+
+	assert.Equal(t, 0.0, *lines[1].Spread, "pick'em")
+	assert.Nil(t, lines[1].Total)
+	assert.Zero(t, lines[1].HomeMoneyline)
+
+	lines, err = ReadCSV(strings.NewReader("game_id,spread\n0022300555,-2\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "0022300555", lines[0].GameID)
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"empty", "", "no header row"},
+		{"unknown columns", "a,b\n1,2\n", "header has none of the columns"},
+		{"bad spread", "game_id,spread\n1,minus two\n", "row 2: invalid spread: 'minus two' is not a number"},
+		{"bad moneyline", "game_id,home_moneyline\n1,-150.5\n", "row 2: invalid home moneyline"},
+		{"no game", "date,home,spread\n2024-01-15,LAL,-2\n", "row 2: needs a game_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadCSV(strings.NewReader(tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestReadJSON(t *testing.T) {
+	lines, err := ReadJSON(strings.NewReader(`[
+		{"game_id": "0022300555", "spread": -6.5, "total": 231},
+		{"date": "2024-01-15", "home": "gsw", "away": "mia", "home_moneyline": -120}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, -6.5, *lines[0].Spread)
+	assert.Equal(t, "GSW", lines[1].Home)
+	assert.Nil(t, lines[1].Spread)
+
+	_, err = ReadJSON(strings.NewReader(`[{"spread": 1}]`))
+	assert.EqualError(t, err, "line 1: needs a game_id, or a date with home and away teams")
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "odds.CSV")
+	require.NoError(t, os.WriteFile(path, []byte("game_id,total\n1,210\n"), 0644))
+	lines, err := Load(path)
+	require.NoError(t, err)
+	assert.Len(t, lines, 1)
+
+	_, err = Load(filepath.Join(dir, "odds.txt"))
+	assert.Error(t, err)
+	path = filepath.Join(dir, "odds.xml")
+	require.NoError(t, os.WriteFile(path, nil, 0644))
+	_, err = Load(path)
+	assert.EqualError(t, err, "unsupported odds file '"+path+"': use .csv or .json")
+}
+
+func TestApply(t *testing.T) {
+	spread, total := -4.5, 220.0
+	games := []nba.Game{
+		{GameID: "g1", Date: "2024-01-15", Status: "Final",
+			HomeTeam: nba.Team{Code: "LAL", Score: 110}, AwayTeam: nba.Team{Code: "BOS", Score: 104}},
+		{GameID: "g2", Date: "2024-01-15", Status: "Scheduled",
+			HomeTeam: nba.Team{Code: "GSW"}, AwayTeam: nba.Team{Code: "MIA"}},
+		{GameID: "g3", Date: "2024-01-15", Status: "Final",
+			HomeTeam: nba.Team{Code: "MIL"}, AwayTeam: nba.Team{Code: "CHI"}},
+	}
+	lines := []Line{
+		{GameID: "g1", Spread: &spread, Total: &total, HomeMoneyline: -190},
+		{Date: "2024-01-15", Home: "GSW", Away: "MIA", Spread: &spread},
+		{Date: "2024-01-15", Home: "CHI", Away: "MIL", Spread: &spread}, // Home and away swapped
+		{GameID: "g3", Date: "2024-01-16", Spread: &spread},             // Another day's game
+	}
+
+	assert.Equal(t, 2, Apply(games, lines))
+	require.NotNil(t, games[0].Odds)
+	assert.Equal(t, -190, games[0].Odds.HomeMoneyline)
+	assert.Equal(t, "LAL", games[0].Odds.Cover, "settled")
+	require.NotNil(t, games[1].Odds)
+	assert.Empty(t, games[1].Odds.Cover, "not final")
+	assert.Nil(t, games[2].Odds)
+}
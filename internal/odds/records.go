@@ -0,0 +1,230 @@
+package odds
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Settled results of a line
+const (
+	Push  = "Push"
+	Over  = "Over"
+	Under = "Under"
+)
+
+// Settle works out who covered the spread and whether the total went over,
+// once a game with odds is final
+func Settle(game *nba.Game) {
+	odds := game.Odds
+	if odds == nil || !game.IsFinal() {
+		return
+	}
+	home, away := game.HomeTeam.Score, game.AwayTeam.Score
+
+	if odds.Spread != nil {
+		margin := float64(home-away) + *odds.Spread
+		odds.ATSMargin = &margin
+		switch {
+		case margin > 0:
+			odds.Cover = strings.ToUpper(game.HomeTeam.Code)
+		case margin < 0:
+			odds.Cover = strings.ToUpper(game.AwayTeam.Code)
+		default:
+			odds.Cover = Push
+		}
+	}
+	if odds.Total != nil {
+		switch points := float64(home + away); {
+		case points > *odds.Total:
+			odds.OverUnder = Over
+		case points < *odds.Total:
+			odds.OverUnder = Under
+		default:
+			odds.OverUnder = Push
+		}
+	}
+}
+
+// Record is a win-loss-push record against the spread
+type Record struct {
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+	Pushes int `json:"pushes"`
+}
+
+// add counts one result: a cover, a loss or a push
+func (r *Record) add(covered, pushed bool) {
+	switch {
+	case pushed:
+		r.Pushes++
+	case covered:
+		r.Wins++
+	default:
+		r.Losses++
+	}
+}
+
+// Pct is the share of games won, pushes left out
+func (r Record) Pct() float64 {
+	if r.Wins+r.Losses == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Wins+r.Losses)
+}
+
+// String formats the record as "W-L-P"
+func (r Record) String() string {
+	return fmt.Sprintf("%d-%d-%d", r.Wins, r.Losses, r.Pushes)
+}
+
+// Totals counts games over, under and pushing the total
+type Totals struct {
+	Overs  int `json:"overs"`
+	Unders int `json:"unders"`
+	Pushes int `json:"pushes"`
+}
+
+func (t *Totals) add(result string) {
+	switch result {
+	case Over:
+		t.Overs++
+	case Under:
+		t.Unders++
+	case Push:
+		t.Pushes++
+	}
+}
+
+// String formats the totals as "O-U-P"
+func (t Totals) String() string {
+	return fmt.Sprintf("%d-%d-%d", t.Overs, t.Unders, t.Pushes)
+}
+
+// TeamRecord is a team's record against the spread and the total
+type TeamRecord struct {
+	Team        string  `json:"team"`
+	Name        string  `json:"name"`
+	Games       int     `json:"games"` // Settled games with a spread
+	ATS         Record  `json:"ats"`
+	Pct         float64 `json:"pct"`
+	Home        Record  `json:"home"`
+	Road        Record  `json:"road"`
+	Favorite    Record  `json:"favorite"`
+	Underdog    Record  `json:"underdog"`
+	CoverMargin float64 `json:"cover_margin"` // Average margin against the spread
+	Totals      Totals  `json:"totals"`
+}
+
+// Summary is the league-wide record of home teams and favourites against
+// the spread, and of games against the total
+type Summary struct {
+	Games     int    `json:"games"` // Settled games with a spread
+	Home      Record `json:"home"`
+	Favorites Record `json:"favorites"`
+	Totals    Totals `json:"totals"`
+}
+
+// Report holds the settled lines of a period and each team's records
+type Report struct {
+	Period  string       `json:"period"`
+	Lines   int          `json:"lines"`   // Lines in the odds file
+	Matched int          `json:"matched"` // Games in the period given a line
+	Summary Summary      `json:"summary"`
+	Teams   []TeamRecord `json:"teams"`
+	Games   []nba.Game   `json:"games"` // Settled games, with their odds
+}
+
+// BuildReport tallies the settled games among games, which should already
+// have their lines applied, by team: best against the spread first
+func BuildReport(league nba.League, games []nba.Game, lines, matched int, period string) *Report {
+	report := &Report{Period: period, Lines: lines, Matched: matched, Teams: []TeamRecord{}, Games: []nba.Game{}}
+
+	byTeam := make(map[string]*TeamRecord)
+	team := func(code, name string) *TeamRecord {
+		record, ok := byTeam[code]
+		if !ok {
+			record = &TeamRecord{Team: code, Name: name}
+			if info, found := nba.LookupTeam(league, code); found {
+				record.Name = info.Name
+			}
+			byTeam[code] = record
+		}
+		return record
+	}
+
+	for _, game := range games {
+		odds := game.Odds
+		if odds == nil || (odds.Cover == "" && odds.OverUnder == "") {
+			continue
+		}
+		report.Games = append(report.Games, game)
+		home := team(strings.ToUpper(game.HomeTeam.Code), game.HomeTeam.Name)
+		away := team(strings.ToUpper(game.AwayTeam.Code), game.AwayTeam.Name)
+
+		report.Summary.Totals.add(odds.OverUnder)
+		home.Totals.add(odds.OverUnder)
+		away.Totals.add(odds.OverUnder)
+		if odds.Cover == "" {
+			continue
+		}
+
+		pushed := odds.Cover == Push
+		homeCovered := odds.Cover == home.Team
+		spread := *odds.Spread
+		report.Summary.Games++
+		report.Summary.Home.add(homeCovered, pushed)
+		if spread != 0 {
+			report.Summary.Favorites.add(homeCovered == (spread < 0), pushed)
+		}
+
+		for _, side := range []struct {
+			record  *TeamRecord
+			home    bool
+			covered bool
+			spread  float64
+			margin  float64
+		}{
+			{home, true, homeCovered, spread, *odds.ATSMargin},
+			{away, false, !homeCovered, -spread, -*odds.ATSMargin},
+		} {
+			record := side.record
+			record.Games++
+			record.CoverMargin += side.margin
+			record.ATS.add(side.covered, pushed)
+			if side.home {
+				record.Home.add(side.covered, pushed)
+			} else {
+				record.Road.add(side.covered, pushed)
+			}
+			switch {
+			case side.spread < 0:
+				record.Favorite.add(side.covered, pushed)
+			case side.spread > 0:
+				record.Underdog.add(side.covered, pushed)
+			}
+		}
+	}
+
+	for _, record := range byTeam {
+		if record.Games > 0 {
+			record.CoverMargin = math.Round(record.CoverMargin/float64(record.Games)*10) / 10
+		}
+		record.Pct = math.Round(record.ATS.Pct()*1000) / 1000
+		report.Teams = append(report.Teams, *record)
+	}
+	sort.Slice(report.Teams, func(i, j int) bool {
+		a, b := report.Teams[i], report.Teams[j]
+		if a.Pct != b.Pct {
+			return a.Pct > b.Pct
+		}
+		if a.ATS.Wins != b.ATS.Wins {
+			return a.ATS.Wins > b.ATS.Wins
+		}
+		return a.Team < b.Team
+	})
+	return report
+}
@@ -0,0 +1,101 @@
+package odds
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// settled builds a final game with a spread and total, settled
+func settled(home, away string, homeScore, awayScore int, spread, total float64) nba.Game {
+	game := nba.Game{
+		Date:     "2024-01-15",
+		Status:   "Final",
+		HomeTeam: nba.Team{Code: home, Name: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Name: away, Score: awayScore},
+		Odds:     &nba.Odds{Spread: &spread, Total: &total},
+	}
+	Settle(&game)
+	return game
+}
+
+func TestSettle(t *testing.T) {
+	tests := []struct {
+		name          string
+		home, away    int
+		spread, total float64
+		wantCover     string
+		wantMargin    float64
+		wantOverUnder string
+	}{
+		{"favourite covers", 110, 100, -6.5, 205.5, "LAL", 3.5, Over},
+		{"favourite wins, underdog covers", 104, 100, -6.5, 210, "BOS", -2.5, Under},
+		{"underdog wins", 98, 100, 3, 198, "LAL", 1, Push},
+		{"push", 106, 100, -6, 220, Push, 0, Under},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := settled("LAL", "BOS", tt.home, tt.away, tt.spread, tt.total)
+			assert.Equal(t, tt.wantCover, game.Odds.Cover)
+			require.NotNil(t, game.Odds.ATSMargin)
+			assert.Equal(t, tt.wantMargin, *game.Odds.ATSMargin)
+			assert.Equal(t, tt.wantOverUnder, game.Odds.OverUnder)
+		})
+	}
+
+	game := nba.Game{Status: "Live", Odds: &nba.Odds{}}
+	Settle(&game)
+	assert.Empty(t, game.Odds.Cover, "not final")
+	assert.Nil(t, game.Odds.ATSMargin)
+
+	total := 200.0
+	game = nba.Game{Status: "Final", HomeTeam: nba.Team{Score: 101}, AwayTeam: nba.Team{Score: 100}, Odds: &nba.Odds{Total: &total}}
+	Settle(&game)
+	assert.Empty(t, game.Odds.Cover, "no spread")
+	assert.Equal(t, Over, game.Odds.OverUnder)
+}
+
+func TestBuildReport(t *testing.T) {
+	games := []nba.Game{
+		settled("LAL", "BOS", 110, 100, -6.5, 205.5), // LAL covers as favourite, over
+		settled("BOS", "LAL", 104, 100, 2, 210),      // BOS covers as underdog, under
+		settled("LAL", "MIA", 106, 100, -6, 220),     // Push, under
+		{Status: "Scheduled", Odds: &nba.Odds{}},     // Not settled
+		{Status: "Final"},                            // No line
+	}
+
+	report := BuildReport(nba.NBA, games, 6, 4, "2024-01-15")
+	assert.Equal(t, 6, report.Lines)
+	assert.Equal(t, 4, report.Matched)
+	assert.Len(t, report.Games, 3)
+	assert.Equal(t, Summary{
+		Games:     3,
+		Home:      Record{Wins: 2, Losses: 0, Pushes: 1},
+		Favorites: Record{Wins: 1, Losses: 1, Pushes: 1},
+		Totals:    Totals{Overs: 1, Unders: 2},
+	}, report.Summary)
+
+	require.Len(t, report.Teams, 3)
+	bos := report.Teams[0]
+	assert.Equal(t, "BOS", bos.Team, "level with LAL, by code")
+	assert.Equal(t, "Boston Celtics", bos.Name)
+	assert.Equal(t, Record{Wins: 1, Losses: 1}, bos.ATS)
+	assert.Equal(t, 0.5, bos.Pct)
+	assert.Equal(t, Record{Wins: 1}, bos.Home)
+	assert.Equal(t, Record{Losses: 1}, bos.Road)
+	assert.Equal(t, Record{Wins: 1, Losses: 1}, bos.Underdog)
+	assert.Equal(t, Record{}, bos.Favorite)
+
+	lal := report.Teams[1]
+	assert.Equal(t, "LAL", lal.Team)
+	assert.Equal(t, "1-1-1", lal.ATS.String())
+	assert.Equal(t, Record{Wins: 1, Losses: 1, Pushes: 1}, lal.Favorite)
+	assert.Equal(t, -0.8, lal.CoverMargin)
+	assert.Equal(t, "1-2-0", lal.Totals.String())
+
+	mia := report.Teams[2]
+	assert.Equal(t, Record{Pushes: 1}, mia.ATS)
+	assert.Equal(t, 0.0, mia.Pct)
+}
@@ -0,0 +1,138 @@
+package players
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Averages are a player's per-game figures and shooting percentages
+type Averages struct {
+	Minutes           float64 `json:"minutes"`
+	Points            float64 `json:"points"`
+	Rebounds          float64 `json:"rebounds"`
+	Assists           float64 `json:"assists"`
+	Steals            float64 `json:"steals"`
+	Blocks            float64 `json:"blocks"`
+	Turnovers         float64 `json:"turnovers"`
+	ThreePointersMade float64 `json:"three_pointers_made"`
+	FieldGoalPct      float64 `json:"field_goal_pct"`
+	ThreePointPct     float64 `json:"three_point_pct"`
+	FreeThrowPct      float64 `json:"free_throw_pct"`
+}
+
+// PlayerSeason is a player's totals and averages over a set of games
+type PlayerSeason struct {
+	PlayerID string        `json:"player_id"`
+	Name     string        `json:"name"`
+	Team     string        `json:"team"` // Team of the player's latest game
+	Games    int           `json:"games"`
+	Starts   int           `json:"starts"`
+	Minutes  int           `json:"minutes"`
+	Totals   nba.TeamStats `json:"totals"`
+	PerGame  Averages      `json:"per_game"`
+
+	lastDate string
+}
+
+// GameLine is one player's line in one game
+type GameLine struct {
+	Date     string          `json:"date"`
+	GameID   string          `json:"game_id"`
+	Team     string          `json:"team"`
+	Opponent string          `json:"opponent"`
+	Home     bool            `json:"home"`
+	Player   nba.PlayerStats `json:"player"`
+}
+
+// Lines flattens box scores into one line per player per game, in date order
+func Lines(boxScores []nba.BoxScore) []GameLine {
+	var lines []GameLine
+	for _, box := range boxScores {
+		sides := []struct {
+			team, opponent nba.TeamBoxScore
+			home           bool
+		}{{box.HomeTeam, box.AwayTeam, true}, {box.AwayTeam, box.HomeTeam, false}}
+		for _, side := range sides {
+			for _, player := range side.team.Players {
+				lines = append(lines, GameLine{
+					Date:     box.Date,
+					GameID:   box.GameID,
+					Team:     strings.ToUpper(side.team.Code),
+					Opponent: strings.ToUpper(side.opponent.Code),
+					Home:     side.home,
+					Player:   player,
+				})
+			}
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].Date < lines[j].Date })
+	return lines
+}
+
+// Aggregate adds up every player's lines in the box scores into season
+// totals and per-game averages, ordered by points per game
+func Aggregate(boxScores []nba.BoxScore) []PlayerSeason {
+	byPlayer := make(map[string]*PlayerSeason)
+	for _, line := range Lines(boxScores) {
+		season, ok := byPlayer[line.Player.PlayerID]
+		if !ok {
+			season = &PlayerSeason{PlayerID: line.Player.PlayerID, Name: line.Player.Name}
+			byPlayer[line.Player.PlayerID] = season
+		}
+		if line.Date >= season.lastDate {
+			season.Team = line.Team
+			season.lastDate = line.Date
+		}
+		season.Games++
+		if line.Player.Starter {
+			season.Starts++
+		}
+		season.Minutes += line.Player.Minutes
+		season.Totals = season.Totals.Add(line.Player.Stats)
+	}
+
+	seasons := make([]PlayerSeason, 0, len(byPlayer))
+	for _, season := range byPlayer {
+		season.PerGame = averages(season)
+		seasons = append(seasons, *season)
+	}
+	sort.Slice(seasons, func(i, j int) bool {
+		if seasons[i].PerGame.Points != seasons[j].PerGame.Points {
+			return seasons[i].PerGame.Points > seasons[j].PerGame.Points
+		}
+		return seasons[i].PlayerID < seasons[j].PlayerID
+	})
+	return seasons
+}
+
+// averages divides a player's totals by games played
+func averages(season *PlayerSeason) Averages {
+	var a Averages
+	if season.Games == 0 {
+		return a
+	}
+	games := float64(season.Games)
+	totals := season.Totals
+	a.Minutes = float64(season.Minutes) / games
+	a.Points = float64(totals.Points) / games
+	a.Rebounds = float64(totals.Rebounds()) / games
+	a.Assists = float64(totals.Assists) / games
+	a.Steals = float64(totals.Steals) / games
+	a.Blocks = float64(totals.Blocks) / games
+	a.Turnovers = float64(totals.Turnovers) / games
+	a.ThreePointersMade = float64(totals.ThreePointersMade) / games
+	a.FieldGoalPct = percentage(totals.FieldGoalsMade, totals.FieldGoalsAttempted)
+	a.ThreePointPct = percentage(totals.ThreePointersMade, totals.ThreePointersAttempted)
+	a.FreeThrowPct = percentage(totals.FreeThrowsMade, totals.FreeThrowsAttempted)
+	return a
+}
+
+// percentage returns made over attempted, or zero without attempts
+func percentage(made, attempted int) float64 {
+	if attempted == 0 {
+		return 0
+	}
+	return float64(made) / float64(attempted)
+}
@@ -0,0 +1,80 @@
+package players
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// line builds a player's box score line from points, rebounds and assists,
+// with every point scored on two-point field goals out of twice the attempts
+func line(id, name string, points, rebounds, assists int) nba.PlayerStats {
+	return nba.PlayerStats{
+		PlayerID: id,
+		Name:     name,
+		Starter:  true,
+		Minutes:  30,
+		Stats: nba.TeamStats{
+			Points:              points,
+			FieldGoalsMade:      points / 2,
+			FieldGoalsAttempted: points,
+			DefensiveRebounds:   rebounds,
+			Assists:             assists,
+		},
+	}
+}
+
+func box(date, home, away string, homePlayers, awayPlayers []nba.PlayerStats) nba.BoxScore {
+	return nba.BoxScore{
+		GameID:   date + home + away,
+		Date:     date,
+		HomeTeam: nba.TeamBoxScore{Code: home, Players: homePlayers},
+		AwayTeam: nba.TeamBoxScore{Code: away, Players: awayPlayers},
+	}
+}
+
+func testBoxScores() []nba.BoxScore {
+	return []nba.BoxScore{
+		box("2024-01-02", "DEN", "LAL",
+			[]nba.PlayerStats{line("DEN-01", "Nikola Jokić", 30, 14, 10), line("DEN-02", "Jamal Murray", 20, 4, 6)},
+			[]nba.PlayerStats{line("LAL-01", "LeBron James", 28, 8, 9)}),
+		box("2024-01-01", "LAL", "BOS",
+			[]nba.PlayerStats{line("LAL-01", "LeBron James", 24, 6, 11)},
+			[]nba.PlayerStats{line("BOS-01", "Jayson Tatum", 36, 9, 4)}),
+	}
+}
+
+func TestLines(t *testing.T) {
+	lines := Lines(testBoxScores())
+	require.Len(t, lines, 5)
+	assert.Equal(t, "2024-01-01", lines[0].Date, "date order")
+	assert.Equal(t, "LAL", lines[0].Team)
+	assert.Equal(t, "BOS", lines[0].Opponent)
+	assert.True(t, lines[0].Home)
+	assert.False(t, lines[1].Home)
+}
+
+func TestAggregate(t *testing.T) {
+	seasons := Aggregate(testBoxScores())
+	require.Len(t, seasons, 4)
+	assert.Equal(t, "BOS-01", seasons[0].PlayerID, "most points per game first")
+
+	var lebron PlayerSeason
+	for _, season := range seasons {
+		if season.PlayerID == "LAL-01" {
+			lebron = season
+		}
+	}
+	assert.Equal(t, "LAL", lebron.Team)
+	assert.Equal(t, 2, lebron.Games)
+	assert.Equal(t, 2, lebron.Starts)
+	assert.Equal(t, 60, lebron.Minutes)
+	assert.Equal(t, 52, lebron.Totals.Points)
+	assert.InDelta(t, 26, lebron.PerGame.Points, 1e-9)
+	assert.InDelta(t, 7, lebron.PerGame.Rebounds, 1e-9)
+	assert.InDelta(t, 10, lebron.PerGame.Assists, 1e-9)
+	assert.InDelta(t, 0.5, lebron.PerGame.FieldGoalPct, 1e-9)
+	assert.Zero(t, lebron.PerGame.ThreePointPct, "no attempts")
+}
@@ -0,0 +1,202 @@
+package players
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// PerfectShootingAttempts is the fewest field goal attempts that count as a
+// perfect shooting night
+const PerfectShootingAttempts = 8
+
+// featRule detects one kind of feat. Rules in the same group are exclusive:
+// only the first that applies is tagged, so a triple-double is not also
+// tagged a double-double.
+type featRule struct {
+	tag, noun, group string
+	applies          func(nba.TeamStats) bool
+}
+
+var featRules = []featRule{
+	{"quadruple_double", "quadruple-double", "doubles", func(s nba.TeamStats) bool { return DoubleDigits(s) >= 4 }},
+	{"triple_double", "triple-double", "doubles", func(s nba.TeamStats) bool { return DoubleDigits(s) == 3 }},
+	{"double_double", "double-double", "doubles", func(s nba.TeamStats) bool { return DoubleDigits(s) == 2 }},
+	{"50_points", "50-point game", "points", func(s nba.TeamStats) bool { return s.Points >= 50 }},
+	{"40_points", "40-point game", "points", func(s nba.TeamStats) bool { return s.Points >= 40 }},
+	{"20_rebounds", "20-rebound game", "rebounds", func(s nba.TeamStats) bool { return s.Rebounds() >= 20 }},
+	{"15_assists", "15-assist game", "assists", func(s nba.TeamStats) bool { return s.Assists >= 15 }},
+	{"5_steals", "5-steal game", "steals", func(s nba.TeamStats) bool { return s.Steals >= 5 }},
+	{"5_blocks", "5-block game", "blocks", func(s nba.TeamStats) bool { return s.Blocks >= 5 }},
+	{"10_threes", "10-three game", "threes", func(s nba.TeamStats) bool { return s.ThreePointersMade >= 10 }},
+	{"perfect_shooting", "perfect shooting night", "shooting", func(s nba.TeamStats) bool {
+		return s.FieldGoalsAttempted >= PerfectShootingAttempts && s.FieldGoalsMade == s.FieldGoalsAttempted
+	}},
+}
+
+// DoubleDigits counts the categories among points, rebounds, assists,
+// steals and blocks with ten or more
+func DoubleDigits(s nba.TeamStats) int {
+	count := 0
+	for _, value := range []int{s.Points, s.Rebounds(), s.Assists, s.Steals, s.Blocks} {
+		if value >= 10 {
+			count++
+		}
+	}
+	return count
+}
+
+// FeatTags lists the tags of every kind of feat, rarest first within a group
+func FeatTags() []string {
+	tags := make([]string, len(featRules))
+	for i, rule := range featRules {
+		tags[i] = rule.tag
+	}
+	return tags
+}
+
+// FeatLabel returns the label of a feat tag, e.g. "Triple-double"
+func FeatLabel(tag string) (string, bool) {
+	for _, rule := range featRules {
+		if rule.tag == tag {
+			return label(rule.noun), true
+		}
+	}
+	return "", false
+}
+
+// DetectFeats scans box scores for feats, in date order. Each feat carries
+// the player's count of that feat so far across the box scores, so they
+// should start at the beginning of the season.
+func DetectFeats(boxScores []nba.BoxScore) []nba.Feat {
+	counts := make(map[string]int)
+	var feats []nba.Feat
+	for _, line := range Lines(boxScores) {
+		matched := make(map[string]bool)
+		for _, rule := range featRules {
+			if matched[rule.group] || !rule.applies(line.Player.Stats) {
+				continue
+			}
+			matched[rule.group] = true
+
+			key := line.Player.PlayerID + "|" + rule.tag
+			counts[key]++
+			feat := nba.Feat{
+				Tag:         rule.tag,
+				Label:       label(rule.noun),
+				Date:        line.Date,
+				GameID:      line.GameID,
+				PlayerID:    line.Player.PlayerID,
+				Name:        line.Player.Name,
+				Team:        line.Team,
+				Opponent:    line.Opponent,
+				Line:        StatLine(line.Player.Stats),
+				SeasonCount: counts[key],
+			}
+			matchup := "@ " + line.Opponent
+			if line.Home {
+				matchup = "vs " + line.Opponent
+			}
+			feat.Description = fmt.Sprintf("%s (%s): %s %s, %s (%s of the season)",
+				feat.Name, feat.Team, rule.noun, matchup, feat.Line, nba.Ordinal(feat.SeasonCount))
+			feats = append(feats, feat)
+		}
+	}
+	return feats
+}
+
+// AddFeats sets each game's feats from feats detected in its box score
+func AddFeats(games []nba.Game, feats []nba.Feat) {
+	byGame := make(map[string][]nba.Feat)
+	for _, feat := range feats {
+		key := feat.Date + "|" + feat.GameID
+		byGame[key] = append(byGame[key], feat)
+	}
+	for i := range games {
+		games[i].Feats = byGame[games[i].Date+"|"+games[i].GameID]
+	}
+}
+
+// PlayerFeats counts one player's feats by tag
+type PlayerFeats struct {
+	PlayerID string         `json:"player_id"`
+	Name     string         `json:"name"`
+	Team     string         `json:"team"`
+	Counts   map[string]int `json:"counts"`
+	Total    int            `json:"total"`
+}
+
+// FeatCount is how often one kind of feat happened
+type FeatCount struct {
+	Tag   string `json:"tag"`
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+// FeatsReport holds every feat over a period with counts per player and per tag
+type FeatsReport struct {
+	Period  string        `json:"period"`
+	Counts  []FeatCount   `json:"counts"`
+	Players []PlayerFeats `json:"players"`
+	Feats   []nba.Feat    `json:"feats"`
+}
+
+// BuildFeatsReport detects the feats in the box scores and counts them by
+// player, most feats first, and by tag. History holds the season's earlier
+// box scores: their feats count toward each season count but are not reported.
+func BuildFeatsReport(history, boxScores []nba.BoxScore, period string) *FeatsReport {
+	inPeriod := make(map[string]bool, len(boxScores))
+	for _, box := range boxScores {
+		inPeriod[box.Date+"|"+box.GameID] = true
+	}
+	var feats []nba.Feat
+	for _, feat := range DetectFeats(append(append([]nba.BoxScore(nil), history...), boxScores...)) {
+		if inPeriod[feat.Date+"|"+feat.GameID] {
+			feats = append(feats, feat)
+		}
+	}
+	report := &FeatsReport{Period: period, Feats: feats, Players: CountFeats(feats)}
+
+	byTag := make(map[string]int)
+	for _, feat := range feats {
+		byTag[feat.Tag]++
+	}
+	for _, rule := range featRules {
+		report.Counts = append(report.Counts, FeatCount{Tag: rule.tag, Label: label(rule.noun), Count: byTag[rule.tag]})
+	}
+	return report
+}
+
+// CountFeats counts each player's feats by tag, most feats first
+func CountFeats(feats []nba.Feat) []PlayerFeats {
+	byPlayer := make(map[string]*PlayerFeats)
+	for _, feat := range feats {
+		player, ok := byPlayer[feat.PlayerID]
+		if !ok {
+			player = &PlayerFeats{PlayerID: feat.PlayerID, Name: feat.Name, Counts: make(map[string]int)}
+			byPlayer[feat.PlayerID] = player
+		}
+		player.Team = feat.Team
+		player.Counts[feat.Tag]++
+		player.Total++
+	}
+
+	players := make([]PlayerFeats, 0, len(byPlayer))
+	for _, player := range byPlayer {
+		players = append(players, *player)
+	}
+	sort.Slice(players, func(i, j int) bool {
+		if players[i].Total != players[j].Total {
+			return players[i].Total > players[j].Total
+		}
+		return players[i].PlayerID < players[j].PlayerID
+	})
+	return players
+}
+
+// label capitalises a feat's noun, e.g. "Triple-double"
+func label(noun string) string {
+	return strings.ToUpper(noun[:1]) + noun[1:]
+}
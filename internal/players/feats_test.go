@@ -0,0 +1,88 @@
+package players
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func featBoxScores() []nba.BoxScore {
+	perfect := line("DEN-03", "Aaron Gordon", 20, 5, 2)
+	perfect.Stats.FieldGoalsAttempted = perfect.Stats.FieldGoalsMade
+	big := line("LAL-02", "Anthony Davis", 42, 21, 3)
+	big.Stats.Blocks = 6
+
+	return []nba.BoxScore{
+		box("2024-01-03", "DEN", "LAL",
+			[]nba.PlayerStats{line("DEN-01", "Nikola Jokić", 26, 12, 11), perfect},
+			[]nba.PlayerStats{big}),
+		box("2024-01-01", "DEN", "BOS",
+			[]nba.PlayerStats{line("DEN-01", "Nikola Jokić", 30, 14, 10), line("DEN-02", "Jamal Murray", 20, 4, 6)},
+			[]nba.PlayerStats{line("BOS-01", "Jayson Tatum", 36, 10, 4)}),
+	}
+}
+
+func TestDetectFeats(t *testing.T) {
+	feats := DetectFeats(featBoxScores())
+
+	var tags []string
+	for _, feat := range feats {
+		tags = append(tags, feat.PlayerID+" "+feat.Tag)
+	}
+	assert.Equal(t, []string{
+		"DEN-01 triple_double",
+		"BOS-01 double_double",
+		"DEN-01 triple_double",
+		"DEN-03 perfect_shooting",
+		"LAL-02 double_double",
+		"LAL-02 40_points",
+		"LAL-02 20_rebounds",
+		"LAL-02 5_blocks",
+	}, tags, "date order, one feat per group")
+
+	assert.Equal(t, 1, feats[0].SeasonCount)
+	assert.Equal(t, 2, feats[2].SeasonCount, "second triple-double")
+	assert.Equal(t, "Triple-double", feats[2].Label)
+	assert.Equal(t, "DEN", feats[2].Team)
+	assert.Equal(t, "LAL", feats[2].Opponent)
+	assert.Equal(t, "Nikola Jokić (DEN): triple-double vs LAL, 26 PTS, 12 REB, 11 AST (2nd of the season)", feats[2].Description)
+	assert.Equal(t, "Anthony Davis (LAL): 40-point game @ DEN, 42 PTS, 21 REB, 3 AST, 6 BLK (1st of the season)", feats[5].Description)
+}
+
+func TestAddFeats(t *testing.T) {
+	feats := DetectFeats(featBoxScores())
+	games := []nba.Game{
+		{GameID: "2024-01-03DENLAL", Date: "2024-01-03"},
+		{GameID: "2024-01-05DENLAL", Date: "2024-01-05", Feats: []nba.Feat{{Tag: "stale"}}},
+	}
+	AddFeats(games, feats)
+	assert.Len(t, games[0].Feats, 6)
+	assert.Nil(t, games[1].Feats)
+}
+
+func TestBuildFeatsReport(t *testing.T) {
+	report := BuildFeatsReport(nil, featBoxScores(), "2024-01-01 to 2024-01-03")
+	require.Len(t, report.Feats, 8)
+	require.Len(t, report.Counts, len(FeatTags()))
+	assert.Equal(t, FeatCount{Tag: "triple_double", Label: "Triple-double", Count: 2}, report.Counts[1])
+
+	require.Len(t, report.Players, 4)
+	assert.Equal(t, "LAL-02", report.Players[0].PlayerID, "most feats first")
+	assert.Equal(t, 4, report.Players[0].Total)
+	assert.Equal(t, 2, report.Players[1].Counts["triple_double"])
+
+	boxScores := featBoxScores()
+	report = BuildFeatsReport(boxScores[1:], boxScores[:1], "2024-01-03")
+	require.Len(t, report.Feats, 6, "only the period's feats")
+	assert.Equal(t, "DEN-01", report.Feats[0].PlayerID)
+	assert.Equal(t, 2, report.Feats[0].SeasonCount, "counted from the season start")
+	assert.Equal(t, 1, report.Counts[1].Count)
+
+	label, ok := FeatLabel("perfect_shooting")
+	assert.True(t, ok)
+	assert.Equal(t, "Perfect shooting night", label)
+	_, ok = FeatLabel("dunk")
+	assert.False(t, ok)
+}
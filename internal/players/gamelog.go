@@ -0,0 +1,121 @@
+package players
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// PlayerGame is one game in a player's game log
+type PlayerGame struct {
+	Date          string        `json:"date"`
+	GameID        string        `json:"game_id"`
+	Team          string        `json:"team"`
+	Opponent      string        `json:"opponent"`
+	Home          bool          `json:"home"`
+	Result        string        `json:"result"` // Team's result, W or L
+	TeamScore     int           `json:"team_score"`
+	OpponentScore int           `json:"opponent_score"`
+	Starter       bool          `json:"starter"`
+	Minutes       int           `json:"minutes"`
+	PlusMinus     int           `json:"plus_minus"`
+	Line          string        `json:"line"` // e.g. "28 PTS, 11 REB, 9 AST"
+	GameScore     float64       `json:"game_score"`
+	Stats         nba.TeamStats `json:"stats"`
+}
+
+// Matchup describes the game as "vs OPP" at home or "@ OPP" on the road
+func (g PlayerGame) Matchup() string {
+	if g.Home {
+		return "vs " + g.Opponent
+	}
+	return "@ " + g.Opponent
+}
+
+// Score describes the final score from the player's team's side, e.g. "W 112-104"
+func (g PlayerGame) Score() string {
+	return fmt.Sprintf("%s %d-%d", g.Result, g.TeamScore, g.OpponentScore)
+}
+
+// PlayerGameLog is a player's games in date order, with averages over them
+type PlayerGameLog struct {
+	Player    nba.Player    `json:"player"`
+	Period    string        `json:"period"`
+	Games     []PlayerGame  `json:"games"`
+	Wins      int           `json:"wins"`
+	Losses    int           `json:"losses"`
+	Record    string        `json:"record"` // Team's record in the logged games
+	PlusMinus int           `json:"plus_minus"`
+	Totals    nba.TeamStats `json:"totals"`
+	Averages  Averages      `json:"averages"`
+}
+
+// BuildPlayerGameLog builds a player's game log from box scores. When last
+// is greater than zero only the most recent games are kept, and the record
+// and averages cover just those.
+func BuildPlayerGameLog(player nba.Player, boxScores []nba.BoxScore, last int, period string) *PlayerGameLog {
+	gameLog := &PlayerGameLog{
+		Player: player,
+		Period: period,
+		Games:  []PlayerGame{},
+	}
+
+	for _, box := range boxScores {
+		sides := []struct {
+			team, opponent nba.TeamBoxScore
+			home           bool
+		}{{box.HomeTeam, box.AwayTeam, true}, {box.AwayTeam, box.HomeTeam, false}}
+		for _, side := range sides {
+			for _, line := range side.team.Players {
+				if line.PlayerID != player.ID {
+					continue
+				}
+				teamScore, opponentScore := side.team.Totals.Points, side.opponent.Totals.Points
+				result := "L"
+				if teamScore > opponentScore {
+					result = "W"
+				}
+				gameLog.Games = append(gameLog.Games, PlayerGame{
+					Date:          box.Date,
+					GameID:        box.GameID,
+					Team:          strings.ToUpper(side.team.Code),
+					Opponent:      strings.ToUpper(side.opponent.Code),
+					Home:          side.home,
+					Result:        result,
+					TeamScore:     teamScore,
+					OpponentScore: opponentScore,
+					Starter:       line.Starter,
+					Minutes:       line.Minutes,
+					PlusMinus:     line.PlusMinus,
+					Line:          StatLine(line.Stats),
+					GameScore:     math.Round(GameScore(line.Stats)*10) / 10,
+					Stats:         line.Stats,
+				})
+			}
+		}
+	}
+	sort.SliceStable(gameLog.Games, func(i, j int) bool { return gameLog.Games[i].Date < gameLog.Games[j].Date })
+	if last > 0 && len(gameLog.Games) > last {
+		gameLog.Games = gameLog.Games[len(gameLog.Games)-last:]
+	}
+
+	season := &PlayerSeason{}
+	for _, game := range gameLog.Games {
+		if game.Result == "W" {
+			gameLog.Wins++
+		} else {
+			gameLog.Losses++
+		}
+		gameLog.PlusMinus += game.PlusMinus
+		season.Games++
+		season.Minutes += game.Minutes
+		season.Totals = season.Totals.Add(game.Stats)
+	}
+	gameLog.Record = fmt.Sprintf("%d-%d", gameLog.Wins, gameLog.Losses)
+	gameLog.Totals = season.Totals
+	gameLog.Averages = averages(season)
+	return gameLog
+}
@@ -0,0 +1,49 @@
+package players
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPlayerGameLog(t *testing.T) {
+	boxScores := testBoxScores()
+	boxScores[0].HomeTeam.Totals.Points = 120
+	boxScores[0].AwayTeam.Totals.Points = 110
+	boxScores[1].HomeTeam.Totals.Points = 105
+	boxScores[1].AwayTeam.Totals.Points = 99
+	boxScores[0].AwayTeam.Players[0].PlusMinus = -8
+	boxScores[1].HomeTeam.Players[0].PlusMinus = 12
+
+	lebron := nba.Player{ID: "LAL-01", Name: "LeBron James", Team: "LAL"}
+	gameLog := BuildPlayerGameLog(lebron, boxScores, 0, "2024-01-01 to 2024-01-02")
+	require.Len(t, gameLog.Games, 2)
+
+	first := gameLog.Games[0]
+	assert.Equal(t, "2024-01-01", first.Date, "date order")
+	assert.Equal(t, "vs BOS", first.Matchup())
+	assert.Equal(t, "W 105-99", first.Score())
+	assert.Equal(t, 12, first.PlusMinus)
+	assert.Equal(t, "24 PTS, 6 REB, 11 AST", first.Line)
+
+	second := gameLog.Games[1]
+	assert.Equal(t, "@ DEN", second.Matchup())
+	assert.Equal(t, "L 110-120", second.Score())
+
+	assert.Equal(t, "1-1", gameLog.Record)
+	assert.Equal(t, 4, gameLog.PlusMinus)
+	assert.Equal(t, 52, gameLog.Totals.Points)
+	assert.InDelta(t, 26, gameLog.Averages.Points, 1e-9)
+
+	gameLog = BuildPlayerGameLog(lebron, boxScores, 1, "")
+	require.Len(t, gameLog.Games, 1)
+	assert.Equal(t, "2024-01-02", gameLog.Games[0].Date, "most recent game kept")
+	assert.Equal(t, "0-1", gameLog.Record)
+	assert.InDelta(t, 28, gameLog.Averages.Points, 1e-9)
+
+	unknown := BuildPlayerGameLog(nba.Player{ID: "NYK-01"}, boxScores, 0, "")
+	assert.Empty(t, unknown.Games)
+	assert.Equal(t, "0-0", unknown.Record)
+}
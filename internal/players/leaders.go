@@ -0,0 +1,254 @@
+package players
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Leaderboard defaults
+const (
+	DefaultTopPlayers    = 10
+	DefaultDailyTopLines = 3
+)
+
+// Qualifiers set the games and shot attempts needed for a place on a leaderboard
+type Qualifiers struct {
+	MinGamesPct           float64 `json:"min_games_pct"`            // Share of the most games played by any player
+	MinFieldGoalAttempts  float64 `json:"min_field_goal_attempts"`  // Per game, for field goal %
+	MinThreePointAttempts float64 `json:"min_three_point_attempts"` // Per game, for three-point %
+	MinFreeThrowAttempts  float64 `json:"min_free_throw_attempts"`  // Per game, for free throw %
+}
+
+// DefaultQualifiers returns minimums close to the NBA's own, scaled to any
+// number of games
+func DefaultQualifiers() Qualifiers {
+	return Qualifiers{
+		MinGamesPct:           0.7,
+		MinFieldGoalAttempts:  5,
+		MinThreePointAttempts: 2.5,
+		MinFreeThrowAttempts:  2,
+	}
+}
+
+// category is a leaderboard statistic
+type category struct {
+	key, name string
+	average   func(Averages) float64
+	total     func(nba.TeamStats) int // Nil for percentages
+	attempts  func(nba.TeamStats) int // Shots behind a percentage
+	minimum   func(Qualifiers) float64
+}
+
+var categories = []category{
+	{key: "points", name: "Points", average: func(a Averages) float64 { return a.Points },
+		total: func(s nba.TeamStats) int { return s.Points }},
+	{key: "rebounds", name: "Rebounds", average: func(a Averages) float64 { return a.Rebounds },
+		total: func(s nba.TeamStats) int { return s.Rebounds() }},
+	{key: "assists", name: "Assists", average: func(a Averages) float64 { return a.Assists },
+		total: func(s nba.TeamStats) int { return s.Assists }},
+	{key: "steals", name: "Steals", average: func(a Averages) float64 { return a.Steals },
+		total: func(s nba.TeamStats) int { return s.Steals }},
+	{key: "blocks", name: "Blocks", average: func(a Averages) float64 { return a.Blocks },
+		total: func(s nba.TeamStats) int { return s.Blocks }},
+	{key: "threes", name: "Three-Pointers Made", average: func(a Averages) float64 { return a.ThreePointersMade },
+		total: func(s nba.TeamStats) int { return s.ThreePointersMade }},
+	{key: "fg_pct", name: "Field Goal %", average: func(a Averages) float64 { return a.FieldGoalPct },
+		attempts: func(s nba.TeamStats) int { return s.FieldGoalsAttempted },
+		minimum:  func(q Qualifiers) float64 { return q.MinFieldGoalAttempts }},
+	{key: "three_pct", name: "Three-Point %", average: func(a Averages) float64 { return a.ThreePointPct },
+		attempts: func(s nba.TeamStats) int { return s.ThreePointersAttempted },
+		minimum:  func(q Qualifiers) float64 { return q.MinThreePointAttempts }},
+	{key: "ft_pct", name: "Free Throw %", average: func(a Averages) float64 { return a.FreeThrowPct },
+		attempts: func(s nba.TeamStats) int { return s.FreeThrowsAttempted },
+		minimum:  func(q Qualifiers) float64 { return q.MinFreeThrowAttempts }},
+}
+
+// Leader is a player's place on a leaderboard
+type Leader struct {
+	Rank     int     `json:"rank"`
+	PlayerID string  `json:"player_id"`
+	Name     string  `json:"name"`
+	Team     string  `json:"team"`
+	Games    int     `json:"games"`
+	Value    float64 `json:"value"`
+}
+
+// Leaderboard ranks the players in one category
+type Leaderboard struct {
+	Key        string   `json:"key"`
+	Category   string   `json:"category"`
+	Percentage bool     `json:"percentage"`
+	Totals     bool     `json:"totals"` // Ranked by totals rather than per game
+	Leaders    []Leader `json:"leaders"`
+}
+
+// TopPerformer is one of the best individual games of a day
+type TopPerformer struct {
+	Date      string  `json:"date"`
+	GameID    string  `json:"game_id"`
+	PlayerID  string  `json:"player_id"`
+	Name      string  `json:"name"`
+	Team      string  `json:"team"`
+	Opponent  string  `json:"opponent"`
+	Home      bool    `json:"home"`
+	Line      string  `json:"line"` // e.g. "34 PTS, 12 REB, 8 AST"
+	GameScore float64 `json:"game_score"`
+}
+
+// LeadersReport holds the leaderboards, daily top performers and every
+// player's totals over a period
+type LeadersReport struct {
+	Period        string         `json:"period"`
+	Qualifiers    Qualifiers     `json:"qualifiers"`
+	MinGames      int            `json:"min_games"`
+	Leaderboards  []Leaderboard  `json:"leaderboards"`
+	TopPerformers []TopPerformer `json:"top_performers"`
+	Players       []PlayerSeason `json:"players"`
+}
+
+// BuildLeadersReport aggregates the box scores and ranks the top players in
+// each category, per game or by totals, along with each day's best lines
+func BuildLeadersReport(boxScores []nba.BoxScore, qualifiers Qualifiers, top, daily int, totals bool, period string) *LeadersReport {
+	seasons := Aggregate(boxScores)
+	report := &LeadersReport{
+		Period:        period,
+		Qualifiers:    qualifiers,
+		MinGames:      minGames(seasons, qualifiers),
+		TopPerformers: DailyTopPerformers(Lines(boxScores), daily),
+		Players:       seasons,
+	}
+	for _, c := range categories {
+		report.Leaderboards = append(report.Leaderboards, leaderboard(c, seasons, qualifiers, report.MinGames, top, totals))
+	}
+	return report
+}
+
+// minGames returns the games needed to qualify: a share of the most played
+func minGames(seasons []PlayerSeason, qualifiers Qualifiers) int {
+	most := 0
+	for _, season := range seasons {
+		if season.Games > most {
+			most = season.Games
+		}
+	}
+	return int(math.Ceil(float64(most) * qualifiers.MinGamesPct))
+}
+
+// leaderboard ranks the qualified players in a category, sharing ranks on ties
+func leaderboard(c category, seasons []PlayerSeason, qualifiers Qualifiers, minGames, top int, totals bool) Leaderboard {
+	board := Leaderboard{Key: c.key, Category: c.name, Percentage: c.total == nil, Totals: totals && c.total != nil}
+
+	value := func(season PlayerSeason) float64 {
+		if board.Totals {
+			return float64(c.total(season.Totals))
+		}
+		return c.average(season.PerGame)
+	}
+
+	var qualified []PlayerSeason
+	for _, season := range seasons {
+		// Totals need no minimum; averages and percentages do
+		if !board.Totals && season.Games < minGames {
+			continue
+		}
+		if c.attempts != nil && float64(c.attempts(season.Totals)) < c.minimum(qualifiers)*float64(season.Games) {
+			continue
+		}
+		qualified = append(qualified, season)
+	}
+	sort.SliceStable(qualified, func(i, j int) bool {
+		if value(qualified[i]) != value(qualified[j]) {
+			return value(qualified[i]) > value(qualified[j])
+		}
+		return qualified[i].PlayerID < qualified[j].PlayerID
+	})
+
+	for i, season := range qualified {
+		if i == top {
+			break
+		}
+		rank := i + 1
+		if i > 0 && value(season) == board.Leaders[i-1].Value {
+			rank = board.Leaders[i-1].Rank
+		}
+		board.Leaders = append(board.Leaders, Leader{
+			Rank:     rank,
+			PlayerID: season.PlayerID,
+			Name:     season.Name,
+			Team:     season.Team,
+			Games:    season.Games,
+			Value:    value(season),
+		})
+	}
+	return board
+}
+
+// DailyTopPerformers returns the best lines of each day by game score, at
+// most perDay a day, in date order
+func DailyTopPerformers(lines []GameLine, perDay int) []TopPerformer {
+	byDate := make(map[string][]GameLine)
+	var dates []string
+	for _, line := range lines {
+		if _, ok := byDate[line.Date]; !ok {
+			dates = append(dates, line.Date)
+		}
+		byDate[line.Date] = append(byDate[line.Date], line)
+	}
+	sort.Strings(dates)
+
+	var performers []TopPerformer
+	for _, date := range dates {
+		day := byDate[date]
+		sort.SliceStable(day, func(i, j int) bool {
+			return GameScore(day[i].Player.Stats) > GameScore(day[j].Player.Stats)
+		})
+		for i, line := range day {
+			if i == perDay {
+				break
+			}
+			performers = append(performers, TopPerformer{
+				Date:      line.Date,
+				GameID:    line.GameID,
+				PlayerID:  line.Player.PlayerID,
+				Name:      line.Player.Name,
+				Team:      line.Team,
+				Opponent:  line.Opponent,
+				Home:      line.Home,
+				Line:      StatLine(line.Player.Stats),
+				GameScore: math.Round(GameScore(line.Player.Stats)*10) / 10,
+			})
+		}
+	}
+	return performers
+}
+
+// GameScore rates a single game with John Hollinger's game score
+func GameScore(s nba.TeamStats) float64 {
+	return float64(s.Points) + 0.4*float64(s.FieldGoalsMade) - 0.7*float64(s.FieldGoalsAttempted) -
+		0.4*float64(s.FreeThrowsAttempted-s.FreeThrowsMade) + 0.7*float64(s.OffensiveRebounds) +
+		0.3*float64(s.DefensiveRebounds) + float64(s.Steals) + 0.7*float64(s.Assists) +
+		0.7*float64(s.Blocks) - 0.4*float64(s.PersonalFouls) - float64(s.Turnovers)
+}
+
+// StatLine summarises a game as points, rebounds and assists, adding steals,
+// blocks and threes when there were at least three
+func StatLine(s nba.TeamStats) string {
+	parts := []string{
+		fmt.Sprintf("%d PTS", s.Points),
+		fmt.Sprintf("%d REB", s.Rebounds()),
+		fmt.Sprintf("%d AST", s.Assists),
+	}
+	for _, extra := range []struct {
+		value int
+		label string
+	}{{s.Steals, "STL"}, {s.Blocks, "BLK"}, {s.ThreePointersMade, "3PM"}} {
+		if extra.value >= 3 {
+			parts = append(parts, fmt.Sprintf("%d %s", extra.value, extra.label))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
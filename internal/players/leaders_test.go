@@ -0,0 +1,77 @@
+package players
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findBoard(t *testing.T, report *LeadersReport, key string) Leaderboard {
+	t.Helper()
+	for _, board := range report.Leaderboards {
+		if board.Key == key {
+			return board
+		}
+	}
+	require.Failf(t, "leaderboard not found", "no %s leaderboard", key)
+	return Leaderboard{}
+}
+
+func TestBuildLeadersReport(t *testing.T) {
+	report := BuildLeadersReport(testBoxScores(), DefaultQualifiers(), 10, 1, false, "January")
+	assert.Equal(t, 2, report.MinGames, "70% of two games")
+
+	// Only LeBron James played both games
+	points := findBoard(t, report, "points")
+	require.Len(t, points.Leaders, 1)
+	assert.Equal(t, "LeBron James", points.Leaders[0].Name)
+	assert.InDelta(t, 26, points.Leaders[0].Value, 1e-9)
+	assert.False(t, points.Percentage)
+
+	fgPct := findBoard(t, report, "fg_pct")
+	assert.True(t, fgPct.Percentage)
+	require.Len(t, fgPct.Leaders, 1)
+
+	assert.Empty(t, findBoard(t, report, "three_pct").Leaders, "nobody attempted a three")
+
+	require.Len(t, report.TopPerformers, 2, "one a day")
+	assert.Equal(t, "Jayson Tatum", report.TopPerformers[0].Name)
+	assert.Equal(t, "Nikola Jokić", report.TopPerformers[1].Name)
+	assert.Equal(t, "30 PTS, 14 REB, 10 AST", report.TopPerformers[1].Line)
+	assert.Len(t, report.Players, 4)
+}
+
+func TestBuildLeadersReport_Totals(t *testing.T) {
+	report := BuildLeadersReport(testBoxScores(), DefaultQualifiers(), 2, 0, true, "January")
+
+	points := findBoard(t, report, "points")
+	assert.True(t, points.Totals)
+	require.Len(t, points.Leaders, 2, "totals need no minimum games, capped at top")
+	assert.Equal(t, "LeBron James", points.Leaders[0].Name)
+	assert.InDelta(t, 52, points.Leaders[0].Value, 1e-9)
+	assert.Equal(t, "Jayson Tatum", points.Leaders[1].Name)
+
+	assert.False(t, findBoard(t, report, "fg_pct").Totals, "percentages are never totals")
+	assert.Empty(t, report.TopPerformers)
+}
+
+func TestLeaderboard_SharedRanks(t *testing.T) {
+	seasons := []PlayerSeason{
+		{PlayerID: "A", Games: 1, PerGame: Averages{Assists: 10}},
+		{PlayerID: "B", Games: 1, PerGame: Averages{Assists: 10}},
+		{PlayerID: "C", Games: 1, PerGame: Averages{Assists: 8}},
+	}
+	board := leaderboard(categories[2], seasons, DefaultQualifiers(), 1, 10, false)
+	require.Len(t, board.Leaders, 3)
+	assert.Equal(t, []int{1, 1, 3}, []int{board.Leaders[0].Rank, board.Leaders[1].Rank, board.Leaders[2].Rank})
+}
+
+func TestGameScore(t *testing.T) {
+	stats := nba.TeamStats{Points: 30, FieldGoalsMade: 12, FieldGoalsAttempted: 20, FreeThrowsMade: 4,
+		FreeThrowsAttempted: 5, OffensiveRebounds: 2, DefensiveRebounds: 8, Assists: 5, Steals: 1,
+		Blocks: 1, Turnovers: 3, PersonalFouls: 2}
+	assert.InDelta(t, 30+4.8-14-0.4+1.4+2.4+1+3.5+0.7-0.8-3, GameScore(stats), 1e-9)
+	assert.Equal(t, "30 PTS, 10 REB, 5 AST", StatLine(stats))
+}
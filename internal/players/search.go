@@ -0,0 +1,164 @@
+package players
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// MinMatchScore is the lowest score a search result can have
+const MinMatchScore = 0.6
+
+// Match is a player found by a name search
+type Match struct {
+	Player nba.Player `json:"player"`
+	Score  float64    `json:"score"` // 1 for an exact match
+}
+
+// accents maps accented letters to their plain forms
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ā", "a", "ą", "a",
+	"ç", "c", "ć", "c", "č", "c",
+	"đ", "d", "ď", "d",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ē", "e", "ė", "e", "ę", "e", "ě", "e",
+	"ğ", "g", "ģ", "g",
+	"í", "i", "ì", "i", "î", "i", "ï", "i", "ī", "i", "ı", "i",
+	"ķ", "k",
+	"ł", "l", "ļ", "l", "ľ", "l",
+	"ñ", "n", "ń", "n", "ņ", "n", "ň", "n",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ō", "o", "ő", "o",
+	"ř", "r",
+	"ś", "s", "š", "s", "ş", "s", "ß", "ss",
+	"ť", "t", "ţ", "t",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ū", "u", "ů", "u", "ű", "u",
+	"ý", "y", "ÿ", "y",
+	"ź", "z", "ż", "z", "ž", "z",
+)
+
+// Fold lowercases a name and strips accents and punctuation, so that
+// "Nikola Jokić" and "nikola jokic" compare equal
+func Fold(name string) string {
+	name = accents.Replace(strings.ToLower(name))
+	var folded strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			folded.WriteRune(r)
+		case r == '\'' || r == '.':
+			// D'Angelo and P.J. are searched without punctuation
+		default:
+			folded.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(folded.String()), " ")
+}
+
+// Directory returns every rostered player in a league
+func Directory(league nba.League) []nba.Player {
+	var directory []nba.Player
+	for _, team := range nba.Teams(league) {
+		directory = append(directory, nba.Roster(league, team.Code)...)
+	}
+	return directory
+}
+
+// Search finds the players whose names match the query, best first. Names
+// match whole, by any of their words, or close enough to allow for typos,
+// so "jokic", "Nikola Jokić" and "nikola jokc" all find Nikola Jokić.
+func Search(candidates []nba.Player, query string) []Match {
+	query = Fold(query)
+	if query == "" {
+		return nil
+	}
+
+	var matches []Match
+	for _, player := range candidates {
+		if score := matchScore(query, Fold(player.Name)); score >= MinMatchScore {
+			matches = append(matches, Match{Player: player, Score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Player.Name < matches[j].Player.Name
+	})
+	return matches
+}
+
+// matchScore rates how well a folded query matches a folded name, from 0 to 1
+func matchScore(query, name string) float64 {
+	if query == name {
+		return 1
+	}
+	if strings.HasPrefix(name, query+" ") || strings.HasSuffix(name, " "+query) || strings.Contains(name, " "+query+" ") {
+		return 0.95 // A whole word or words, such as a surname
+	}
+
+	// Otherwise each query word is scored against the closest word of the
+	// name, allowing prefixes and typos
+	words := strings.Fields(name)
+	total := 0.0
+	for _, term := range strings.Fields(query) {
+		best := 0.0
+		for _, word := range words {
+			if score := wordScore(term, word); score > best {
+				best = score
+			}
+		}
+		total += best
+	}
+	byWord := 0.9 * total / float64(len(strings.Fields(query)))
+	whole := 0.9 * similarity(query, name)
+	if whole > byWord {
+		return whole
+	}
+	return byWord
+}
+
+// wordScore rates a query word against a word of a name
+func wordScore(term, word string) float64 {
+	if term == word {
+		return 1
+	}
+	if len([]rune(term)) >= 3 && strings.HasPrefix(word, term) {
+		return 0.9
+	}
+	return similarity(term, word)
+}
+
+// similarity is one minus the edit distance over the longer length
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(ra, rb))/float64(longest)
+}
+
+// editDistance is the Levenshtein distance between two words
+func editDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
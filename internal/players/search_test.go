@@ -0,0 +1,59 @@
+package players
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFold(t *testing.T) {
+	assert.Equal(t, "nikola jokic", Fold("Nikola Jokić"))
+	assert.Equal(t, "kristaps porzingis", Fold("  Kristaps   Porziņģis "))
+	assert.Equal(t, "alperen sengun", Fold("Alperen Şengün"))
+	assert.Equal(t, "dangelo russell", Fold("D'Angelo Russell"))
+	assert.Equal(t, "pj washington", Fold("P.J. Washington"))
+	assert.Equal(t, "shai gilgeous alexander", Fold("Shai Gilgeous-Alexander"))
+}
+
+func TestSearch(t *testing.T) {
+	directory := Directory(nba.NBA)
+	require.NotEmpty(t, directory)
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"Nikola Jokić", "Nikola Jokić"},
+		{"nikola jokic", "Nikola Jokić"},
+		{"jokic", "Nikola Jokić"},
+		{"nikola jokc", "Nikola Jokić"},
+		{"porzingis", "Kristaps Porziņģis"},
+		{"sengun", "Alperen Şengün"},
+		{"giannis", "Giannis Antetokounmpo"},
+		{"gilgeous", "Shai Gilgeous-Alexander"},
+		{"wembanyama", "Victor Wembanyama"},
+		{"wemban", "Victor Wembanyama"},
+		{"steph curry", "Stephen Curry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			matches := Search(directory, tt.query)
+			require.NotEmpty(t, matches)
+			assert.Equal(t, tt.want, matches[0].Player.Name)
+		})
+	}
+
+	matches := Search(directory, "Nikola Jokic")
+	assert.Equal(t, 1.0, matches[0].Score)
+	assert.Equal(t, "DEN", matches[0].Player.Team)
+
+	// Every Nikola matches a first-name search equally
+	matches = Search(directory, "nikola")
+	require.GreaterOrEqual(t, len(matches), 3)
+	assert.Equal(t, matches[0].Score, matches[2].Score)
+
+	assert.Empty(t, Search(directory, "xqzw"))
+	assert.Empty(t, Search(directory, "  "))
+}
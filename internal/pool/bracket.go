@@ -0,0 +1,126 @@
+package pool
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// BracketPick is one participant's pick of a team to win its series in a
+// playoff round, and optionally in how many games
+type BracketPick struct {
+	Participant string `json:"participant"`
+	Round       int    `json:"round"` // 1 for the first round
+	Winner      string `json:"winner"`
+	Games       int    `json:"games,omitempty"` // Series length picked; zero when not picked
+}
+
+// LoadBracket reads bracket picks from a JSON, CSV or Excel file, chosen by
+// its extension. Excel picks are read from the named sheet, or the first one.
+func LoadBracket(path, sheet string) ([]BracketPick, error) {
+	var picks []BracketPick
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		picks, err = readBracketFile(path, ReadBracketJSON)
+	case ".csv":
+		picks, err = readBracketFile(path, ReadBracketCSV)
+	case ".xlsx":
+		var rows [][]string
+		if rows, err = excelRows(path, sheet); err == nil {
+			picks, err = readBracketRows(rows)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported bracket file '%s': use .json, .csv or .xlsx", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading bracket %s: %w", path, err)
+	}
+	return picks, nil
+}
+
+// readBracketFile opens a file and reads bracket picks from it
+func readBracketFile(path string, read func(io.Reader) ([]BracketPick, error)) ([]BracketPick, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return read(file)
+}
+
+// ReadBracketJSON reads a JSON array of bracket picks
+func ReadBracketJSON(r io.Reader) ([]BracketPick, error) {
+	var picks []BracketPick
+	if err := json.NewDecoder(r).Decode(&picks); err != nil {
+		return nil, err
+	}
+	for i := range picks {
+		if err := picks[i].normalize(); err != nil {
+			return nil, fmt.Errorf("pick %d: %w", i+1, err)
+		}
+	}
+	return picks, nil
+}
+
+// ReadBracketCSV reads bracket picks from CSV with a header row
+func ReadBracketCSV(r io.Reader) ([]BracketPick, error) {
+	rows, err := csvRows(r)
+	if err != nil {
+		return nil, err
+	}
+	return readBracketRows(rows)
+}
+
+// readBracketRows reads bracket picks from rows of cells under a header row
+// naming the participant, round, winner and, optionally, games columns
+func readBracketRows(rows [][]string) ([]BracketPick, error) {
+	index, err := columns(rows, "participant", "round", "winner")
+	if err != nil {
+		return nil, err
+	}
+
+	var picks []BracketPick
+	for row, record := range rows[1:] {
+		if blank(record) {
+			continue
+		}
+		cell := func(column string) string { return cellValue(record, index, column) }
+
+		pick := BracketPick{Participant: cell("participant"), Winner: cell("winner")}
+		if pick.Round, err = strconv.Atoi(cell("round")); err != nil {
+			return nil, fmt.Errorf("row %d: invalid round '%s'", row+2, cell("round"))
+		}
+		if games := cell("games"); games != "" {
+			if pick.Games, err = strconv.Atoi(games); err != nil {
+				return nil, fmt.Errorf("row %d: invalid games '%s'", row+2, games)
+			}
+		}
+		if err := pick.normalize(); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row+2, err)
+		}
+		picks = append(picks, pick)
+	}
+	return picks, nil
+}
+
+// normalize upper-cases the picked team and checks the pick is complete
+func (p *BracketPick) normalize() error {
+	p.Participant = strings.TrimSpace(p.Participant)
+	p.Winner = strings.ToUpper(strings.TrimSpace(p.Winner))
+	switch {
+	case p.Participant == "":
+		return fmt.Errorf("no participant")
+	case p.Winner == "":
+		return fmt.Errorf("no winner picked")
+	case p.Round < 1:
+		return fmt.Errorf("round must be 1 or more")
+	case p.Games < 0:
+		return fmt.Errorf("games cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package pool
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBracketCSV(t *testing.T) {
+	picks, err := ReadBracketCSV(strings.NewReader(`participant,round,winner,games
+Ana,1,bos,5
+Ana,4,BOS,
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []BracketPick{
+		{Participant: "Ana", Round: 1, Winner: "BOS", Games: 5},
+		{Participant: "Ana", Round: 4, Winner: "BOS"},
+	}, picks)
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"no winner column", "participant,round\nAna,1\n", "header has no 'winner' column"},
+		{"bad round", "participant,round,winner\nAna,first,BOS\n", "row 2: invalid round 'first'"},
+		{"zero round", "participant,round,winner\nAna,0,BOS\n", "row 2: round must be 1 or more"},
+		{"bad games", "participant,round,winner,games\nAna,1,BOS,six\n", "row 2: invalid games 'six'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadBracketCSV(strings.NewReader(tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadBracket(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bracket.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"participant": "Ana", "round": 2, "winner": "den", "games": 6}]`), 0644))
+	picks, err := LoadBracket(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, []BracketPick{{Participant: "Ana", Round: 2, Winner: "DEN", Games: 6}}, picks)
+
+	require.NoError(t, os.WriteFile(path, []byte(`[{"participant": "Ana", "round": 2}]`), 0644))
+	_, err = LoadBracket(path, "")
+	assert.EqualError(t, err, "reading bracket "+path+": pick 1: no winner picked")
+
+	path = filepath.Join(dir, "bracket.txt")
+	_, err = LoadBracket(path, "")
+	assert.EqualError(t, err, "unsupported bracket file '"+path+"': use .json, .csv or .xlsx")
+}
@@ -0,0 +1,288 @@
+package pool
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/sim"
+)
+
+// BracketConfig sets what a bracket pick is worth
+type BracketConfig struct {
+	Weights   []int `json:"weights"`    // Points for each correct series winner, by round
+	GameBonus int   `json:"game_bonus"` // Extra points when the series length is right too
+}
+
+// DefaultBracketConfig doubles the points for a series winner each round,
+// starting at one, with a one-point bonus for the series length
+func DefaultBracketConfig(league nba.League) BracketConfig {
+	config := BracketConfig{GameBonus: 1}
+	for i := range sim.FormatFor(league).Rounds {
+		config.Weights = append(config.Weights, 1<<i)
+	}
+	return config
+}
+
+// BracketTiebreakers lists, in order, how entries level on points are separated
+var BracketTiebreakers = []string{
+	"Maximum possible points",
+	"Correct series winners",
+	"Correct series lengths",
+}
+
+// EntryPick is a bracket pick with its result so far
+type EntryPick struct {
+	BracketPick
+	RoundName    string `json:"round_name"`
+	Series       string `json:"series,omitempty"` // The picked team's series in the round
+	Result       string `json:"result"`
+	GamesCorrect bool   `json:"games_correct,omitempty"`
+	Points       int    `json:"points"`
+	Possible     int    `json:"possible"` // Points the pick can still add
+}
+
+// Entry is a participant's bracket with its standing
+type Entry struct {
+	Rank         int         `json:"rank"`
+	Participant  string      `json:"participant"`
+	Points       int         `json:"points"`
+	Remaining    int         `json:"remaining"`  // Most points the pending picks can still add
+	MaxPoints    int         `json:"max_points"` // Points plus remaining
+	Correct      int         `json:"correct"`
+	Wrong        int         `json:"wrong"`
+	Pending      int         `json:"pending"`
+	GamesCorrect int         `json:"games_correct"`
+	Champion     string      `json:"champion,omitempty"` // Team picked to win the final round
+	RoundPoints  []int       `json:"round_points"`
+	Picks        []EntryPick `json:"picks"`
+}
+
+// BracketReport is the leaderboard of a bracket challenge
+type BracketReport struct {
+	Season      string        `json:"season"`
+	Rounds      []string      `json:"rounds"`
+	Config      BracketConfig `json:"config"`
+	Tiebreakers []string      `json:"tiebreakers"`
+	Series      []Series      `json:"series"`
+	Entries     []Entry       `json:"entries"`
+}
+
+// Entry returns the entry of a participant
+func (r *BracketReport) Entry(participant string) (Entry, bool) {
+	for _, entry := range r.Entries {
+		if entry.Participant == participant {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
+
+// ScoreBracket scores bracket picks against the series played so far. A
+// pending pick can still score while its team is alive and the round is not
+// over, with the series length bonus while that many games can still win the
+// series.
+func ScoreBracket(league nba.League, picks []BracketPick, series []Series, config BracketConfig) (*BracketReport, error) {
+	format := sim.FormatFor(league)
+	if err := validateBracket(league, format, picks, series, config); err != nil {
+		return nil, err
+	}
+
+	report := &BracketReport{
+		Rounds:      format.Rounds,
+		Config:      config,
+		Tiebreakers: BracketTiebreakers,
+		Series:      series,
+		Entries:     []Entry{},
+	}
+
+	entries := make(map[string]*Entry)
+	var participants []string
+	for _, pick := range picks {
+		entry, ok := entries[pick.Participant]
+		if !ok {
+			entry = &Entry{Participant: pick.Participant, RoundPoints: make([]int, len(format.Rounds))}
+			entries[pick.Participant] = entry
+			participants = append(participants, pick.Participant)
+		}
+
+		settled := settleBracketPick(pick, format, series, config)
+		entry.Picks = append(entry.Picks, settled)
+		entry.Points += settled.Points
+		entry.Remaining += settled.Possible
+		entry.RoundPoints[pick.Round-1] += settled.Points
+		switch settled.Result {
+		case Correct:
+			entry.Correct++
+		case Wrong:
+			entry.Wrong++
+		default:
+			entry.Pending++
+		}
+		if settled.GamesCorrect {
+			entry.GamesCorrect++
+		}
+		if pick.Round == len(format.Rounds) {
+			entry.Champion = pick.Winner
+		}
+	}
+
+	for _, participant := range participants {
+		entry := entries[participant]
+		entry.MaxPoints = entry.Points + entry.Remaining
+		sort.SliceStable(entry.Picks, func(i, j int) bool { return entry.Picks[i].Round < entry.Picks[j].Round })
+		report.Entries = append(report.Entries, *entry)
+	}
+	sort.Slice(report.Entries, func(i, j int) bool {
+		if c := compareEntries(report.Entries[i], report.Entries[j]); c != 0 {
+			return c > 0
+		}
+		return report.Entries[i].Participant < report.Entries[j].Participant
+	})
+	for i := range report.Entries {
+		report.Entries[i].Rank = i + 1
+		if i > 0 && compareEntries(report.Entries[i], report.Entries[i-1]) == 0 {
+			report.Entries[i].Rank = report.Entries[i-1].Rank
+		}
+	}
+	return report, nil
+}
+
+// validateBracket checks the picks fit the league's playoff format, and that
+// no participant picks both teams of a series
+func validateBracket(league nba.League, format sim.Format, picks []BracketPick, series []Series, config BracketConfig) error {
+	if len(config.Weights) != len(format.Rounds) {
+		return fmt.Errorf("%d round weights given, but the %s playoffs have %d rounds", len(config.Weights), league.Name, len(format.Rounds))
+	}
+	if config.GameBonus < 0 {
+		return fmt.Errorf("series length bonus cannot be negative")
+	}
+
+	picked := make(map[string]bool)
+	perRound := make(map[string]int)
+	for _, pick := range picks {
+		if pick.Round > len(format.Rounds) {
+			return fmt.Errorf("%s picked a round %d winner, but the %s playoffs have %d rounds", pick.Participant, pick.Round, league.Name, len(format.Rounds))
+		}
+		round := format.Rounds[pick.Round-1]
+		if _, ok := nba.LookupTeam(league, pick.Winner); !ok {
+			return fmt.Errorf("%s picked unknown team %s in the %s", pick.Participant, pick.Winner, round)
+		}
+		key := fmt.Sprintf("%s|%d|%s", pick.Participant, pick.Round, pick.Winner)
+		if picked[key] {
+			return fmt.Errorf("%s picked %s in the %s more than once", pick.Participant, pick.Winner, round)
+		}
+		picked[key] = true
+		for _, s := range series {
+			if s.Round != pick.Round || !s.HasTeam(pick.Winner) {
+				continue
+			}
+			opponent := s.Home
+			if opponent == pick.Winner {
+				opponent = s.Away
+			}
+			if picked[fmt.Sprintf("%s|%d|%s", pick.Participant, pick.Round, opponent)] {
+				return fmt.Errorf("%s picked both %s and %s in the %s, but they play each other", pick.Participant, opponent, pick.Winner, round)
+			}
+		}
+
+		roundKey := fmt.Sprintf("%s|%d", pick.Participant, pick.Round)
+		perRound[roundKey]++
+		if series := format.RoundSeries(pick.Round - 1); perRound[roundKey] > series {
+			return fmt.Errorf("%s picked more than %d winners in the %s", pick.Participant, series, round)
+		}
+
+		bestOf := format.SeriesLength[pick.Round-1]
+		if pick.Games != 0 && (pick.Games <= bestOf/2 || pick.Games > bestOf) {
+			return fmt.Errorf("%s picked %s to win the %s in %d games, but it is a best of %d", pick.Participant, pick.Winner, round, pick.Games, bestOf)
+		}
+	}
+	return nil
+}
+
+// settleBracketPick works out a pick's result and the points it scored or
+// can still score
+func settleBracketPick(pick BracketPick, format sim.Format, series []Series, config BracketConfig) EntryPick {
+	settled := EntryPick{BracketPick: pick, RoundName: format.Rounds[pick.Round-1], Result: Pending}
+	weight := config.Weights[pick.Round-1]
+
+	var current *Series
+	started := make([]int, pick.Round)
+	for i := range series {
+		s := &series[i]
+		if s.Round > pick.Round {
+			continue
+		}
+		started[s.Round-1]++
+		if !s.HasTeam(pick.Winner) {
+			continue
+		}
+		if s.Round == pick.Round {
+			current = s
+		} else if s.Loser == pick.Winner {
+			settled.Result = Wrong // Knocked out in an earlier round
+		}
+	}
+	for round, count := range started {
+		if count == format.RoundSeries(round) && !teamInRound(series, round+1, pick.Winner) {
+			settled.Result = Wrong // The round was played without the team
+		}
+	}
+	if current != nil {
+		settled.Series = current.Summary()
+	}
+	if settled.Result == Wrong {
+		return settled
+	}
+
+	if current != nil && current.Complete() {
+		settled.Result = Wrong
+		if current.Winner == pick.Winner {
+			settled.Result = Correct
+			settled.Points = weight
+			if pick.Games == current.Games() {
+				settled.GamesCorrect = true
+				settled.Points += config.GameBonus
+			}
+		}
+		return settled
+	}
+
+	settled.Possible = weight
+	if pick.Games != 0 {
+		needed := format.SeriesLength[pick.Round-1]/2 + 1
+		losses := 0
+		if current != nil {
+			_, losses = current.Wins(pick.Winner)
+		}
+		// Winning in the picked length means losing Games-needed games in all
+		if pick.Games-needed >= losses {
+			settled.Possible += config.GameBonus
+		}
+	}
+	return settled
+}
+
+// teamInRound reports whether a team plays a series in a round
+func teamInRound(series []Series, round int, team string) bool {
+	for _, s := range series {
+		if s.Round == round && s.HasTeam(team) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareEntries orders two entries by points and the tiebreakers: positive
+// when a ranks higher, zero when they are level on everything
+func compareEntries(a, b Entry) int {
+	switch {
+	case a.Points != b.Points:
+		return a.Points - b.Points
+	case a.MaxPoints != b.MaxPoints:
+		return a.MaxPoints - b.MaxPoints
+	case a.Correct != b.Correct:
+		return a.Correct - b.Correct
+	}
+	return a.GamesCorrect - b.GamesCorrect
+}
@@ -0,0 +1,122 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSeries() []Series {
+	return []Series{
+		{Round: 1, BestOf: 3, Home: "LVA", Away: "SEA", HomeWins: 2, Winner: "LVA", Loser: "SEA"},
+		{Round: 1, BestOf: 3, Home: "NYL", Away: "ATL", HomeWins: 2, AwayWins: 1, Winner: "NYL", Loser: "ATL"},
+		{Round: 2, BestOf: 5, Home: "LVA", Away: "NYL", AwayWins: 1},
+	}
+}
+
+func TestDefaultBracketConfig(t *testing.T) {
+	assert.Equal(t, BracketConfig{Weights: []int{1, 2, 4, 8}, GameBonus: 1}, DefaultBracketConfig(nba.NBA))
+	assert.Equal(t, []int{1, 2, 4}, DefaultBracketConfig(nba.WNBA).Weights)
+}
+
+func TestScoreBracket(t *testing.T) {
+	picks := []BracketPick{
+		{Participant: "Ana", Round: 1, Winner: "LVA", Games: 2},
+		{Participant: "Ana", Round: 1, Winner: "ATL"},
+		{Participant: "Ana", Round: 2, Winner: "LVA", Games: 4},
+		{Participant: "Ana", Round: 3, Winner: "LVA"},
+		{Participant: "Ben", Round: 1, Winner: "NYL", Games: 3},
+		{Participant: "Ben", Round: 1, Winner: "SEA"},
+		{Participant: "Ben", Round: 2, Winner: "LVA", Games: 3},
+		{Participant: "Ben", Round: 3, Winner: "SEA"},
+		{Participant: "Cal", Round: 3, Winner: "MIN"},
+		{Participant: "Cal", Round: 1, Winner: "LVA"},
+		{Participant: "Cal", Round: 1, Winner: "NYL", Games: 2},
+		{Participant: "Cal", Round: 2, Winner: "NYL", Games: 3},
+	}
+	report, err := ScoreBracket(nba.WNBA, picks, testSeries(), DefaultBracketConfig(nba.WNBA))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"First Round", "Semifinals", "Finals"}, report.Rounds)
+	require.Len(t, report.Entries, 3)
+
+	// Level on points: Cal and Ana can still reach 9, Cal has more winners right
+	cal, ana, ben := report.Entries[0], report.Entries[1], report.Entries[2]
+	assert.Equal(t, []string{"Cal", "Ana", "Ben"}, []string{cal.Participant, ana.Participant, ben.Participant})
+	assert.Equal(t, []int{1, 2, 3}, []int{cal.Rank, ana.Rank, ben.Rank})
+
+	assert.Equal(t, 2, ana.Points)
+	assert.Equal(t, 7, ana.Remaining)
+	assert.Equal(t, 9, ana.MaxPoints)
+	assert.Equal(t, []int{1, 1, 1, 2}, []int{ana.Correct, ana.Wrong, ana.GamesCorrect, ana.Pending})
+	assert.Equal(t, "LVA", ana.Champion)
+	assert.Equal(t, []int{2, 0, 0}, ana.RoundPoints)
+
+	pick := ana.Picks[0]
+	assert.Equal(t, "First Round", pick.RoundName)
+	assert.Equal(t, "LVA beat SEA 2-0", pick.Series)
+	assert.True(t, pick.GamesCorrect)
+	assert.Equal(t, 2, pick.Points)
+	pick = ana.Picks[2]
+	assert.Equal(t, Pending, pick.Result)
+	assert.Equal(t, "NYL leads LVA 1-0", pick.Series)
+	assert.Equal(t, 3, pick.Possible, "LVA can still win in four")
+
+	assert.Equal(t, 2, ben.Picks[2].Possible, "LVA can no longer win in three")
+	assert.Equal(t, Wrong, ben.Picks[3].Result, "SEA is out")
+	assert.Equal(t, 4, ben.MaxPoints)
+
+	assert.Equal(t, 1, cal.Picks[1].Points, "series length wrong")
+	assert.Equal(t, Pending, cal.Picks[3].Result, "MIN's first round series has not started")
+	assert.Equal(t, 9, cal.MaxPoints)
+
+	entry, ok := report.Entry("Ben")
+	assert.True(t, ok)
+	assert.Equal(t, "SEA", entry.Champion)
+}
+
+func TestScoreBracketRoundOver(t *testing.T) {
+	series := append(testSeries()[:2],
+		Series{Round: 1, BestOf: 3, Home: "CON", Away: "IND", HomeWins: 2, Winner: "CON", Loser: "IND"},
+		Series{Round: 1, BestOf: 3, Home: "MIN", Away: "PHO", HomeWins: 1},
+	)
+	picks := []BracketPick{{Participant: "Ana", Round: 1, Winner: "CHI"}, {Participant: "Ana", Round: 2, Winner: "MIN"}}
+
+	report, err := ScoreBracket(nba.WNBA, picks, series, DefaultBracketConfig(nba.WNBA))
+	require.NoError(t, err)
+	entry := report.Entries[0]
+	assert.Equal(t, Wrong, entry.Picks[0].Result, "every first round series started without CHI")
+	assert.Equal(t, Pending, entry.Picks[1].Result)
+	assert.Equal(t, 2, entry.Remaining)
+}
+
+func TestScoreBracketErrors(t *testing.T) {
+	config := DefaultBracketConfig(nba.WNBA)
+	tests := []struct {
+		name    string
+		picks   []BracketPick
+		config  BracketConfig
+		wantErr string
+	}{
+		{"weights", nil, BracketConfig{Weights: []int{1, 2}}, "2 round weights given, but the WNBA playoffs have 3 rounds"},
+		{"round", []BracketPick{{Participant: "Ana", Round: 4, Winner: "LVA"}}, config,
+			"Ana picked a round 4 winner, but the WNBA playoffs have 3 rounds"},
+		{"team", []BracketPick{{Participant: "Ana", Round: 1, Winner: "BOS"}}, config,
+			"Ana picked unknown team BOS in the First Round"},
+		{"twice", []BracketPick{{Participant: "Ana", Round: 2, Winner: "LVA"}, {Participant: "Ana", Round: 2, Winner: "LVA"}}, config,
+			"Ana picked LVA in the Semifinals more than once"},
+		{"too many", []BracketPick{{Participant: "Ana", Round: 3, Winner: "LVA"}, {Participant: "Ana", Round: 3, Winner: "NYL"}}, config,
+			"Ana picked more than 1 winners in the Finals"},
+		{"same series", []BracketPick{{Participant: "Ana", Round: 1, Winner: "SEA"}, {Participant: "Ana", Round: 1, Winner: "LVA"}}, config,
+			"Ana picked both SEA and LVA in the First Round, but they play each other"},
+		{"games", []BracketPick{{Participant: "Ana", Round: 1, Winner: "LVA", Games: 4}}, config,
+			"Ana picked LVA to win the First Round in 4 games, but it is a best of 3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ScoreBracket(nba.WNBA, tt.picks, testSeries(), tt.config)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package pool
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Pick is one participant's predicted winner of a game. The game is the one
+// the picked team plays on the date, or the one with the game ID.
+type Pick struct {
+	Participant string `json:"participant"`
+	Date        string `json:"date"`
+	GameID      string `json:"game_id,omitempty"`
+	Pick        string `json:"pick"`                 // Code of the team picked to win
+	Confidence  int    `json:"confidence,omitempty"` // Points for a correct pick; one when not given
+}
+
+// Load reads picks from a JSON, CSV or Excel file, chosen by its extension.
+// Excel picks are read from the named sheet, or the first one.
+func Load(path, sheet string) ([]Pick, error) {
+	var picks []Pick
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		picks, err = readFile(path, ReadJSON)
+	case ".csv":
+		picks, err = readFile(path, ReadCSV)
+	case ".xlsx":
+		picks, err = ReadExcel(path, sheet)
+	default:
+		return nil, fmt.Errorf("unsupported picks file '%s': use .json, .csv or .xlsx", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading picks %s: %w", path, err)
+	}
+	return picks, nil
+}
+
+// readFile opens a file and reads picks from it
+func readFile(path string, read func(io.Reader) ([]Pick, error)) ([]Pick, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return read(file)
+}
+
+// ReadJSON reads a JSON array of picks
+func ReadJSON(r io.Reader) ([]Pick, error) {
+	var picks []Pick
+	if err := json.NewDecoder(r).Decode(&picks); err != nil {
+		return nil, err
+	}
+	for i := range picks {
+		if err := picks[i].normalize(); err != nil {
+			return nil, fmt.Errorf("pick %d: %w", i+1, err)
+		}
+	}
+	return picks, nil
+}
+
+// ReadCSV reads picks from CSV with a header row
+func ReadCSV(r io.Reader) ([]Pick, error) {
+	rows, err := csvRows(r)
+	if err != nil {
+		return nil, err
+	}
+	return readRows(rows)
+}
+
+// ReadExcel reads picks from a sheet with a header row
+func ReadExcel(path, sheet string) ([]Pick, error) {
+	rows, err := excelRows(path, sheet)
+	if err != nil {
+		return nil, err
+	}
+	return readRows(rows)
+}
+
+// readRows reads picks from rows of cells under a header row naming the
+// participant, date, pick and, optionally, game_id and confidence columns.
+// Other columns are ignored.
+func readRows(rows [][]string) ([]Pick, error) {
+	index, err := columns(rows, "participant", "date", "pick")
+	if err != nil {
+		return nil, err
+	}
+
+	var picks []Pick
+	for row, record := range rows[1:] {
+		if blank(record) {
+			continue
+		}
+		cell := func(column string) string { return cellValue(record, index, column) }
+
+		pick := Pick{Participant: cell("participant"), Date: cell("date"), GameID: cell("game_id"), Pick: cell("pick")}
+		if confidence := cell("confidence"); confidence != "" {
+			value, err := strconv.Atoi(confidence)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid confidence '%s'", row+2, confidence)
+			}
+			pick.Confidence = value
+		}
+		if err := pick.normalize(); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row+2, err)
+		}
+		picks = append(picks, pick)
+	}
+	return picks, nil
+}
+
+// normalize upper-cases the picked team and checks the pick is complete
+func (p *Pick) normalize() error {
+	p.Participant = strings.TrimSpace(p.Participant)
+	p.Pick = strings.ToUpper(strings.TrimSpace(p.Pick))
+	switch {
+	case p.Participant == "":
+		return fmt.Errorf("no participant")
+	case p.Pick == "":
+		return fmt.Errorf("no team picked")
+	case p.Confidence < 0:
+		return fmt.Errorf("confidence cannot be negative")
+	}
+	if _, err := time.Parse("2006-01-02", p.Date); err != nil {
+		return fmt.Errorf("invalid date '%s': use YYYY-MM-DD format", p.Date)
+	}
+	return nil
+}
+
+// DateRange returns the first and last dates picked
+func DateRange(picks []Pick) (string, string) {
+	var first, last string
+	for _, pick := range picks {
+		if first == "" || pick.Date < first {
+			first = pick.Date
+		}
+		if pick.Date > last {
+			last = pick.Date
+		}
+	}
+	return first, last
+}
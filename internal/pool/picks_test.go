@@ -0,0 +1,91 @@
+package pool
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestReadCSV(t *testing.T) {
+	picks, err := ReadCSV(strings.NewReader(`Participant,Date,Pick,Confidence,Notes
+Ana,2024-01-15,lal,3,home upset
+ Ben ,2024-01-15,BOS,,
+,,,,
+`))
+	require.NoError(t, err)
+	require.Len(t, picks, 2, "blank rows skipped")
+	assert.Equal(t, Pick{Participant: "Ana", Date: "2024-01-15", Pick: "LAL", Confidence: 3}, picks[0])
+	assert.Equal(t, Pick{Participant: "Ben", Date: "2024-01-15", Pick: "BOS"}, picks[1])
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"empty", "", "no header row"},
+		{"no pick column", "participant,date\nAna,2024-01-15\n", "header has no 'pick' column"},
+		{"bad confidence", "participant,date,pick,confidence\nAna,2024-01-15,LAL,high\n", "row 2: invalid confidence 'high'"},
+		{"negative confidence", "participant,date,pick,confidence\nAna,2024-01-15,LAL,-1\n", "row 2: confidence cannot be negative"},
+		{"bad date", "participant,date,pick\nAna,15/01/2024,LAL\n", "row 2: invalid date '15/01/2024'"},
+		{"no participant", "participant,date,pick\n,2024-01-15,LAL\n", "row 2: no participant"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadCSV(strings.NewReader(tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestReadJSON(t *testing.T) {
+	picks, err := ReadJSON(strings.NewReader(`[{"participant": "Ana", "date": "2024-01-15", "game_id": "g1", "pick": "bos"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []Pick{{Participant: "Ana", Date: "2024-01-15", GameID: "g1", Pick: "BOS"}}, picks)
+
+	_, err = ReadJSON(strings.NewReader(`[{"participant": "Ana", "date": "2024-01-15"}]`))
+	assert.EqualError(t, err, "pick 1: no team picked")
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "picks.xlsx")
+	file := excelize.NewFile()
+	_, err := file.NewSheet("Week 2")
+	require.NoError(t, err)
+	require.NoError(t, file.SetSheetRow("Week 2", "A1", &[]interface{}{"Participant", "Date", "Pick", "Confidence"}))
+	require.NoError(t, file.SetSheetRow("Week 2", "A2", &[]interface{}{"Ana", "2024-01-22", "gsw", 2}))
+	require.NoError(t, file.SaveAs(path))
+
+	picks, err := Load(path, "Week 2")
+	require.NoError(t, err)
+	assert.Equal(t, []Pick{{Participant: "Ana", Date: "2024-01-22", Pick: "GSW", Confidence: 2}}, picks)
+	_, err = Load(path, "")
+	assert.ErrorContains(t, err, "no header row", "first sheet is empty")
+	_, err = Load(path, "Week 9")
+	assert.ErrorContains(t, err, "reading sheet 'Week 9'")
+
+	path = filepath.Join(dir, "picks.CSV")
+	require.NoError(t, os.WriteFile(path, []byte("participant,date,pick\nAna,2024-01-15,LAL\n"), 0644))
+	picks, err = Load(path, "")
+	require.NoError(t, err)
+	assert.Len(t, picks, 1)
+
+	_, err = Load(filepath.Join(dir, "picks.json"), "")
+	assert.Error(t, err)
+	path = filepath.Join(dir, "picks.txt")
+	_, err = Load(path, "")
+	assert.EqualError(t, err, "unsupported picks file '"+path+"': use .json, .csv or .xlsx")
+}
+
+func TestDateRange(t *testing.T) {
+	first, last := DateRange([]Pick{{Date: "2024-01-17"}, {Date: "2024-01-15"}, {Date: "2024-01-22"}})
+	assert.Equal(t, "2024-01-15", first)
+	assert.Equal(t, "2024-01-22", last)
+}
@@ -0,0 +1,38 @@
+package pool
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const markdownTemplate = `# Pick'em Pool
+{{.Period}}
+
+## Season
+
+| Rank | Participant | Points | Correct | Wrong | Pending | Pct | Points Lost | Weeks Won |
+|---:|---|---:|---:|---:|---:|---:|---:|---:|
+{{range .Season}}| {{.Rank}} | {{.Participant}} | {{.Points}} | {{.Correct}} | {{.Wrong}} | {{.Pending}} | {{printf "%.3f" .Pct}} | {{.PointsLost}} | {{.WeeksWon}} |
+{{end}}
+Ties on points are broken by: {{join .Tiebreakers "; "}}.
+{{range .Weeks}}
+## Week of {{.Start}}
+{{if .Complete}}{{if .Winners}}**Winner:** {{join .Winners ", "}}
+{{end}}{{else}}*In progress*
+{{end}}
+| Rank | Participant | Points | Correct | Wrong | Pending | Pct | Points Lost |
+|---:|---|---:|---:|---:|---:|---:|---:|
+{{range .Standings}}| {{.Rank}} | {{.Participant}} | {{.Points}} | {{.Correct}} | {{.Wrong}} | {{.Pending}} | {{printf "%.3f" .Pct}} | {{.PointsLost}} |
+{{end}}{{end}}`
+
+var markdown = template.Must(template.New("markdown").Funcs(template.FuncMap{"join": strings.Join}).Parse(markdownTemplate))
+
+// Markdown renders the season and weekly leaderboards as Markdown tables
+func Markdown(report *Report) (string, error) {
+	var out strings.Builder
+	if err := markdown.Execute(&out, report); err != nil {
+		return "", fmt.Errorf("rendering Markdown leaderboards: %w", err)
+	}
+	return out.String(), nil
+}
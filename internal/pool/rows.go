@@ -0,0 +1,70 @@
+package pool
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// csvRows reads every row of a CSV file
+func csvRows(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	return reader.ReadAll()
+}
+
+// excelRows reads every row of the named sheet of a workbook, or its first sheet
+func excelRows(path, sheet string) ([][]string, error) {
+	file, err := excelize.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if sheet == "" {
+		sheet = file.GetSheetName(0)
+	}
+	rows, err := file.GetRows(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("reading sheet '%s': %w", sheet, err)
+	}
+	return rows, nil
+}
+
+// columns maps the lower-cased names in the header row to their index,
+// checking the required columns are there
+func columns(rows [][]string, required ...string) (map[string]int, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no header row")
+	}
+	index := make(map[string]int)
+	for i, header := range rows[0] {
+		index[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	for _, column := range required {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("header has no '%s' column: the %s columns are required", column, strings.Join(required, ", "))
+		}
+	}
+	return index, nil
+}
+
+// cellValue returns the trimmed cell of a row in the named column, empty when
+// there is none
+func cellValue(record []string, index map[string]int, column string) string {
+	i, ok := index[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// blank reports whether every cell of a row is empty, as is common at the
+// end of sheets
+func blank(record []string) bool {
+	return strings.TrimSpace(strings.Join(record, "")) == ""
+}
@@ -0,0 +1,244 @@
+package pool
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Pick results
+const (
+	Correct = "Correct"
+	Wrong   = "Wrong"
+	Pending = "Pending" // The game is not final yet
+)
+
+// Tiebreakers lists, in order, how participants level on points are separated
+var Tiebreakers = []string{
+	"Correct picks",
+	"Fewest confidence points lost on wrong picks",
+	"Weeks won (season only)",
+}
+
+// GradedPick is a pick with its game and result
+type GradedPick struct {
+	Pick
+	Week    string `json:"week"`    // Monday of the pick's week
+	Matchup string `json:"matchup"` // e.g. "BOS @ LAL"
+	Winner  string `json:"winner,omitempty"`
+	Result  string `json:"result"`
+	Points  int    `json:"points"`
+}
+
+// value is what a pick is worth when correct
+func (p Pick) value() int {
+	if p.Confidence > 0 {
+		return p.Confidence
+	}
+	return 1
+}
+
+// Standing is a participant's place on a leaderboard
+type Standing struct {
+	Rank        int     `json:"rank"`
+	Participant string  `json:"participant"`
+	Points      int     `json:"points"`
+	Correct     int     `json:"correct"`
+	Wrong       int     `json:"wrong"`
+	Pending     int     `json:"pending"`
+	Pct         float64 `json:"pct"`         // Share of graded picks that were correct
+	PointsLost  int     `json:"points_lost"` // Points staked on wrong picks
+	WeeksWon    int     `json:"weeks_won,omitempty"`
+}
+
+// Week is the leaderboard of one Monday-to-Sunday week
+type Week struct {
+	Start     string     `json:"start"`
+	End       string     `json:"end"`
+	Complete  bool       `json:"complete"`          // Every picked game is final
+	Winners   []string   `json:"winners,omitempty"` // Set once the week is complete
+	Standings []Standing `json:"standings"`
+}
+
+// Report holds a pool's season and weekly leaderboards and every graded pick
+type Report struct {
+	Period      string       `json:"period"`
+	Tiebreakers []string     `json:"tiebreakers"`
+	Season      []Standing   `json:"season"`
+	Weeks       []Week       `json:"weeks"`
+	Picks       []GradedPick `json:"picks"`
+}
+
+// Score grades every pick against the games and ranks the participants each
+// week and over the season. A pick names a game the picked team plays on the
+// pick's date, or its game ID; picks of games not found, or of the same game
+// twice, are an error.
+func Score(picks []Pick, games []nba.Game) (*Report, error) {
+	byID := make(map[string]nba.Game)
+	byTeam := make(map[string]nba.Game)
+	for _, game := range games {
+		byID[game.GameID+"|"+game.Date] = game
+		byTeam[game.Date+"|"+strings.ToUpper(game.HomeTeam.Code)] = game
+		byTeam[game.Date+"|"+strings.ToUpper(game.AwayTeam.Code)] = game
+	}
+
+	first, last := DateRange(picks)
+	report := &Report{
+		Period:      fmt.Sprintf("%s to %s", first, last),
+		Tiebreakers: Tiebreakers,
+		Season:      []Standing{},
+		Weeks:       []Week{},
+		Picks:       []GradedPick{},
+	}
+
+	picked := make(map[string]bool)
+	for _, pick := range picks {
+		game, ok := byTeam[pick.Date+"|"+pick.Pick]
+		if pick.GameID != "" {
+			game, ok = byID[pick.GameID+"|"+pick.Date]
+			ok = ok && game.HasTeam(pick.Pick)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%s picked %s on %s, but no such game was found", pick.Participant, pick.Pick, pick.Date)
+		}
+		key := pick.Participant + "|" + game.Date + "|" + game.GameID
+		if picked[key] {
+			return nil, fmt.Errorf("%s picked the %s %s game more than once", pick.Participant, game.Date, matchup(game))
+		}
+		picked[key] = true
+
+		report.Picks = append(report.Picks, grade(pick, game))
+	}
+	sort.SliceStable(report.Picks, func(i, j int) bool {
+		a, b := report.Picks[i], report.Picks[j]
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if a.Matchup != b.Matchup {
+			return a.Matchup < b.Matchup
+		}
+		return a.Participant < b.Participant
+	})
+
+	byWeek := make(map[string][]GradedPick)
+	var weeks []string
+	for _, pick := range report.Picks {
+		if _, ok := byWeek[pick.Week]; !ok {
+			weeks = append(weeks, pick.Week)
+		}
+		byWeek[pick.Week] = append(byWeek[pick.Week], pick)
+	}
+	weeksWon := make(map[string]int)
+	for _, start := range weeks {
+		week := Week{Start: start, End: addDays(start, 6), Complete: true}
+		for _, pick := range byWeek[start] {
+			if pick.Result == Pending {
+				week.Complete = false
+			}
+		}
+		week.Standings = rank(byWeek[start], nil)
+		if week.Complete {
+			for _, standing := range week.Standings {
+				if standing.Rank == 1 && standing.Points > 0 {
+					week.Winners = append(week.Winners, standing.Participant)
+					weeksWon[standing.Participant]++
+				}
+			}
+		}
+		report.Weeks = append(report.Weeks, week)
+	}
+	report.Season = rank(report.Picks, weeksWon)
+	return report, nil
+}
+
+// grade settles a pick against its game
+func grade(pick Pick, game nba.Game) GradedPick {
+	date, _ := time.Parse("2006-01-02", game.Date)
+	monday := date.AddDate(0, 0, -(int(date.Weekday())+6)%7)
+	graded := GradedPick{Pick: pick, Week: monday.Format("2006-01-02"), Matchup: matchup(game), Result: Pending}
+	graded.GameID = game.GameID
+
+	winner, ok := game.Winner()
+	if !ok {
+		return graded
+	}
+	graded.Winner = strings.ToUpper(winner.Code)
+	graded.Result = Wrong
+	if graded.Winner == pick.Pick {
+		graded.Result = Correct
+		graded.Points = pick.value()
+	}
+	return graded
+}
+
+// rank totals the picks by participant and orders them by points, then the
+// tiebreakers. Participants level on everything share a rank.
+func rank(picks []GradedPick, weeksWon map[string]int) []Standing {
+	byParticipant := make(map[string]*Standing)
+	for _, pick := range picks {
+		standing, ok := byParticipant[pick.Participant]
+		if !ok {
+			standing = &Standing{Participant: pick.Participant, WeeksWon: weeksWon[pick.Participant]}
+			byParticipant[pick.Participant] = standing
+		}
+		switch pick.Result {
+		case Correct:
+			standing.Correct++
+			standing.Points += pick.Points
+		case Wrong:
+			standing.Wrong++
+			standing.PointsLost += pick.value()
+		default:
+			standing.Pending++
+		}
+	}
+
+	standings := make([]Standing, 0, len(byParticipant))
+	for _, standing := range byParticipant {
+		if graded := standing.Correct + standing.Wrong; graded > 0 {
+			standing.Pct = math.Round(float64(standing.Correct)/float64(graded)*1000) / 1000
+		}
+		standings = append(standings, *standing)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if c := compare(standings[i], standings[j]); c != 0 {
+			return c > 0
+		}
+		return standings[i].Participant < standings[j].Participant
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+		if i > 0 && compare(standings[i], standings[i-1]) == 0 {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+	return standings
+}
+
+// compare orders two standings by points and the tiebreakers: positive when
+// a ranks higher, zero when they are level on everything
+func compare(a, b Standing) int {
+	switch {
+	case a.Points != b.Points:
+		return a.Points - b.Points
+	case a.Correct != b.Correct:
+		return a.Correct - b.Correct
+	case a.PointsLost != b.PointsLost:
+		return b.PointsLost - a.PointsLost
+	}
+	return a.WeeksWon - b.WeeksWon
+}
+
+// matchup names a game's teams, e.g. "BOS @ LAL"
+func matchup(game nba.Game) string {
+	return strings.ToUpper(game.AwayTeam.Code) + " @ " + strings.ToUpper(game.HomeTeam.Code)
+}
+
+func addDays(date string, days int) string {
+	t, _ := time.Parse("2006-01-02", date)
+	return t.AddDate(0, 0, days).Format("2006-01-02")
+}
@@ -0,0 +1,130 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testGames() []nba.Game {
+	return []nba.Game{
+		{GameID: "g1", Date: "2024-01-15", Status: "Final",
+			HomeTeam: nba.Team{Code: "LAL", Score: 110}, AwayTeam: nba.Team{Code: "BOS", Score: 104}},
+		{GameID: "g2", Date: "2024-01-15", Status: "Final",
+			HomeTeam: nba.Team{Code: "GSW", Score: 100}, AwayTeam: nba.Team{Code: "MIA", Score: 120}},
+		{GameID: "g1", Date: "2024-01-22", Status: "Final",
+			HomeTeam: nba.Team{Code: "LAL", Score: 101}, AwayTeam: nba.Team{Code: "BOS", Score: 99}},
+		{GameID: "g3", Date: "2024-01-22", Status: "Scheduled",
+			HomeTeam: nba.Team{Code: "CHI"}, AwayTeam: nba.Team{Code: "MIL"}},
+	}
+}
+
+func testPicks() []Pick {
+	return []Pick{
+		{Participant: "Ana", Date: "2024-01-15", Pick: "LAL", Confidence: 2},
+		{Participant: "Ana", Date: "2024-01-15", Pick: "GSW", Confidence: 1},
+		{Participant: "Ben", Date: "2024-01-15", Pick: "LAL", Confidence: 1},
+		{Participant: "Ben", Date: "2024-01-15", Pick: "MIA", Confidence: 1},
+		{Participant: "Cal", Date: "2024-01-15", Pick: "GSW", Confidence: 2},
+		{Participant: "Cal", Date: "2024-01-15", Pick: "LAL", Confidence: 2},
+		{Participant: "Ana", Date: "2024-01-22", GameID: "g1", Pick: "LAL"},
+		{Participant: "Ana", Date: "2024-01-22", Pick: "CHI"},
+		{Participant: "Ben", Date: "2024-01-22", Pick: "LAL"},
+		{Participant: "Cal", Date: "2024-01-22", Pick: "BOS"},
+	}
+}
+
+func TestScore(t *testing.T) {
+	report, err := Score(testPicks(), testGames())
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-15 to 2024-01-22", report.Period)
+	require.Len(t, report.Picks, 10)
+
+	first := report.Picks[0]
+	assert.Equal(t, "2024-01-15", first.Week)
+	assert.Equal(t, "BOS @ LAL", first.Matchup)
+	assert.Equal(t, "g1", first.GameID, "game ID filled in")
+	assert.Equal(t, "LAL", first.Winner)
+	assert.Equal(t, Correct, first.Result)
+	assert.Equal(t, 2, first.Points)
+
+	require.Len(t, report.Weeks, 2)
+	week := report.Weeks[0]
+	assert.Equal(t, "2024-01-21", week.End)
+	assert.True(t, week.Complete)
+	assert.Equal(t, []string{"Ben"}, week.Winners)
+	// Level on two points: Ben has more correct picks, Ana lost fewer points than Cal
+	assert.Equal(t, []string{"Ben", "Ana", "Cal"}, participants(week.Standings))
+	assert.Equal(t, []int{1, 2, 3}, ranks(week.Standings))
+	assert.Equal(t, 1, week.Standings[1].PointsLost)
+	assert.Equal(t, 0.5, week.Standings[1].Pct)
+
+	week = report.Weeks[1]
+	assert.False(t, week.Complete)
+	assert.Empty(t, week.Winners, "no winner until every game is final")
+	assert.Equal(t, []string{"Ana", "Ben", "Cal"}, participants(week.Standings))
+	assert.Equal(t, []int{1, 1, 3}, ranks(week.Standings), "level on everything shares a rank")
+	assert.Equal(t, 1, week.Standings[0].Pending)
+
+	assert.Equal(t, []string{"Ben", "Ana", "Cal"}, participants(report.Season))
+	assert.Equal(t, 3, report.Season[0].Points)
+	assert.Equal(t, 1, report.Season[0].WeeksWon)
+}
+
+func TestScoreWeeksWonTiebreaker(t *testing.T) {
+	standings := []Standing{{Participant: "Ana", Points: 5}, {Participant: "Ben", Points: 5, WeeksWon: 1}}
+	assert.Less(t, compare(standings[0], standings[1]), 0)
+}
+
+func TestScoreErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		picks   []Pick
+		wantErr string
+	}{
+		{"team not playing", []Pick{{Participant: "Ana", Date: "2024-01-15", Pick: "NYK"}},
+			"Ana picked NYK on 2024-01-15, but no such game was found"},
+		{"team not in game", []Pick{{Participant: "Ana", Date: "2024-01-15", GameID: "g1", Pick: "MIA"}},
+			"Ana picked MIA on 2024-01-15"},
+		{"game picked twice", []Pick{
+			{Participant: "Ana", Date: "2024-01-15", Pick: "LAL"},
+			{Participant: "Ana", Date: "2024-01-15", Pick: "BOS"},
+		}, "Ana picked the 2024-01-15 BOS @ LAL game more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Score(tt.picks, testGames())
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	report, err := Score(testPicks(), testGames())
+	require.NoError(t, err)
+	markdown, err := Markdown(report)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "## Season\n")
+	assert.Contains(t, markdown, "| 1 | Ben | 3 | 3 | 0 | 0 | 1.000 | 0 | 1 |")
+	assert.Contains(t, markdown, "## Week of 2024-01-15\n**Winner:** Ben\n")
+	assert.Contains(t, markdown, "## Week of 2024-01-22\n*In progress*\n")
+	assert.Contains(t, markdown, "Ties on points are broken by: Correct picks;")
+}
+
+func participants(standings []Standing) []string {
+	var names []string
+	for _, standing := range standings {
+		names = append(names, standing.Participant)
+	}
+	return names
+}
+
+func ranks(standings []Standing) []int {
+	var ranks []int
+	for _, standing := range standings {
+		ranks = append(ranks, standing.Rank)
+	}
+	return ranks
+}
@@ -0,0 +1,137 @@
+package pool
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/sim"
+)
+
+// Series is a playoff series as played so far
+type Series struct {
+	Round    int    `json:"round"` // 1 for the first round
+	Name     string `json:"name"`  // e.g. "Conference Finals"
+	BestOf   int    `json:"best_of"`
+	Home     string `json:"home"` // Home team in game one, normally the higher seed
+	Away     string `json:"away"`
+	HomeWins int    `json:"home_wins"`
+	AwayWins int    `json:"away_wins"`
+	Winner   string `json:"winner,omitempty"` // Set once a team has won the series
+	Loser    string `json:"loser,omitempty"`
+}
+
+// Games returns the number of games played in the series
+func (s Series) Games() int {
+	return s.HomeWins + s.AwayWins
+}
+
+// Complete reports whether a team has won the series
+func (s Series) Complete() bool {
+	return s.Winner != ""
+}
+
+// HasTeam reports whether the team plays in the series
+func (s Series) HasTeam(code string) bool {
+	return s.Home == code || s.Away == code
+}
+
+// Wins returns the series wins of a team and of its opponent
+func (s Series) Wins(code string) (int, int) {
+	if code == s.Home {
+		return s.HomeWins, s.AwayWins
+	}
+	return s.AwayWins, s.HomeWins
+}
+
+// Summary describes the series, e.g. "BOS leads MIA 3-1" or "BOS wins 4-2"
+func (s Series) Summary() string {
+	leader, trailer := s.Home, s.Away
+	if s.AwayWins > s.HomeWins {
+		leader, trailer = s.Away, s.Home
+	}
+	wins, losses := s.Wins(leader)
+	switch {
+	case s.Complete():
+		return fmt.Sprintf("%s beat %s %d-%d", s.Winner, s.Loser, wins, losses)
+	case wins == losses:
+		return fmt.Sprintf("%s-%s tied %d-%d", s.Home, s.Away, wins, losses)
+	}
+	return fmt.Sprintf("%s leads %s %d-%d", leader, trailer, wins, losses)
+}
+
+// PlayoffSeries groups a league's final playoff games into series. A team's
+// next series is one round on from the last it won. Play-in games, and any
+// other meetings that end before either team wins, are left out once a team
+// moves on to another opponent, as are games of eliminated teams.
+func PlayoffSeries(league nba.League, games []nba.Game) []Series {
+	format := sim.FormatFor(league)
+
+	final := make([]nba.Game, 0, len(games))
+	for _, game := range games {
+		if _, ok := game.Winner(); ok {
+			final = append(final, game)
+		}
+	}
+	sort.SliceStable(final, func(i, j int) bool { return final[i].Date < final[j].Date })
+
+	var all []*Series
+	open := make(map[string]*Series) // Each team's unfinished series
+	roundsWon := make(map[string]int)
+	eliminated := make(map[string]bool)
+	dropped := make(map[*Series]bool)
+	for _, game := range final {
+		home, away := strings.ToUpper(game.HomeTeam.Code), strings.ToUpper(game.AwayTeam.Code)
+		if eliminated[home] || eliminated[away] {
+			continue
+		}
+
+		series := open[home]
+		if series == nil || !series.HasTeam(away) {
+			for _, team := range []string{home, away} {
+				if stale := open[team]; stale != nil {
+					dropped[stale] = true
+					delete(open, stale.Home)
+					delete(open, stale.Away)
+				}
+			}
+			round := roundsWon[home]
+			if roundsWon[away] > round {
+				round = roundsWon[away]
+			}
+			if round >= len(format.Rounds) {
+				continue
+			}
+			series = &Series{Round: round + 1, Name: format.Rounds[round], BestOf: format.SeriesLength[round], Home: home, Away: away}
+			all = append(all, series)
+			open[home], open[away] = series, series
+		}
+
+		winner, _ := game.Winner()
+		if strings.EqualFold(winner.Code, series.Home) {
+			series.HomeWins++
+		} else {
+			series.AwayWins++
+		}
+		if wins, losses := series.Wins(series.Home); wins > series.BestOf/2 || losses > series.BestOf/2 {
+			series.Winner, series.Loser = series.Home, series.Away
+			if losses > wins {
+				series.Winner, series.Loser = series.Away, series.Home
+			}
+			roundsWon[series.Winner]++
+			eliminated[series.Loser] = true
+			delete(open, series.Home)
+			delete(open, series.Away)
+		}
+	}
+
+	result := []Series{}
+	for _, series := range all {
+		if !dropped[series] {
+			result = append(result, *series)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Round < result[j].Round })
+	return result
+}
@@ -0,0 +1,62 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// playoffGames plays a game a day from the first date, each won by the
+// third team named in its result, the home team coming first
+func playoffGames(start string, results ...[3]string) []nba.Game {
+	var games []nba.Game
+	for i, result := range results {
+		home, away, winner := result[0], result[1], result[2]
+		game := nba.Game{GameID: "g", Date: addDays(start, i), Status: "Final",
+			HomeTeam: nba.Team{Code: home, Score: 100}, AwayTeam: nba.Team{Code: away, Score: 100}}
+		if winner == home {
+			game.HomeTeam.Score += 10
+		} else {
+			game.AwayTeam.Score += 10
+		}
+		games = append(games, game)
+	}
+	return games
+}
+
+func TestPlayoffSeries(t *testing.T) {
+	games := playoffGames("2024-09-15",
+		[3]string{"LVA", "CHI", "CHI"}, // Play-in style meeting, left out once LVA moves on
+		[3]string{"LVA", "SEA", "LVA"},
+		[3]string{"NYL", "ATL", "NYL"},
+		[3]string{"SEA", "LVA", "LVA"},
+		[3]string{"ATL", "NYL", "ATL"},
+		[3]string{"ATL", "NYL", "NYL"},
+		[3]string{"SEA", "NYL", "NYL"}, // SEA is out
+		[3]string{"LVA", "NYL", "NYL"},
+	)
+	games = append(games, nba.Game{Date: "2024-09-30", Status: "Scheduled",
+		HomeTeam: nba.Team{Code: "LVA"}, AwayTeam: nba.Team{Code: "NYL"}})
+
+	series := PlayoffSeries(nba.WNBA, games)
+	require.Len(t, series, 3)
+
+	assert.Equal(t, 1, series[0].Round)
+	assert.Equal(t, "First Round", series[0].Name)
+	assert.Equal(t, 3, series[0].BestOf)
+	assert.Equal(t, "LVA", series[0].Winner)
+	assert.Equal(t, "SEA", series[0].Loser)
+	assert.Equal(t, 2, series[0].Games())
+	assert.Equal(t, "LVA beat SEA 2-0", series[0].Summary())
+
+	assert.Equal(t, "NYL", series[1].Winner)
+	assert.Equal(t, "NYL beat ATL 2-1", series[1].Summary())
+
+	assert.Equal(t, 2, series[2].Round)
+	assert.False(t, series[2].Complete())
+	assert.Equal(t, "NYL leads LVA 1-0", series[2].Summary())
+	wins, losses := series[2].Wins("LVA")
+	assert.Equal(t, []int{0, 1}, []int{wins, losses})
+}
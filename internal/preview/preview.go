@@ -0,0 +1,153 @@
+package preview
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// formGames is the number of recent games in a team's form guide
+const formGames = 10
+
+// TeamPreview is one team's form going into a game
+type TeamPreview struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Record     string `json:"record"`      // Season record, e.g. "30-12"
+	VenueLabel string `json:"venue_label"` // "Home" or "Road"
+	Venue      string `json:"venue"`       // Record at home for the home team, on the road for the away team
+	LastTen    string `json:"last_ten"`    // Record over the last 10 games, e.g. "7-3"
+	Form       string `json:"form"`        // Results of the last 10 games, oldest first
+	Streak     string `json:"streak"`      // e.g. "W3"
+	LastGame   string `json:"last_game,omitempty"`
+	RestDays   int    `json:"rest_days"` // Full days off before the game; -1 before the team's first game
+	BackToBack bool   `json:"back_to_back"`
+}
+
+// Rest describes the team's rest, e.g. "2 days" or "back-to-back"
+func (t TeamPreview) Rest() string {
+	switch {
+	case t.RestDays < 0:
+		return "-"
+	case t.BackToBack:
+		return "back-to-back"
+	case t.RestDays == 1:
+		return "1 day"
+	default:
+		return fmt.Sprintf("%d days", t.RestDays)
+	}
+}
+
+// Preview gathers what to know before a scheduled game
+type Preview struct {
+	GameID          string          `json:"game_id"`
+	Date            string          `json:"date"`
+	Time            string          `json:"time"`
+	Home            TeamPreview     `json:"home"`
+	Away            TeamPreview     `json:"away"`
+	Series          string          `json:"series"` // Season series so far, e.g. "LAL leads 2-1"
+	Meetings        []nba.Meeting   `json:"meetings"`
+	Prediction      *nba.Prediction `json:"prediction,omitempty"`
+	PredictedWinner string          `json:"predicted_winner,omitempty"`
+}
+
+// Matchup names the game as "Away @ Home"
+func (p Preview) Matchup() string {
+	return p.Away.Name + " @ " + p.Home.Name
+}
+
+// BuildPreviews builds a preview of every scheduled game in games. History
+// holds the season's games before the previews' dates; its completed games
+// give the records, form and series, and every game counts towards rest.
+func BuildPreviews(league nba.League, games, history []nba.Game) []Preview {
+	var previews []Preview
+	for _, game := range games {
+		if game.Status != "Scheduled" {
+			continue
+		}
+
+		before := gamesBefore(history, game.Date)
+		h2h := nba.BuildHeadToHead(game.HomeTeam.Code, game.AwayTeam.Code, before)
+		preview := Preview{
+			GameID:     game.GameID,
+			Date:       game.Date,
+			Time:       game.Time,
+			Home:       teamPreview(league, game.HomeTeam, true, game.Date, before),
+			Away:       teamPreview(league, game.AwayTeam, false, game.Date, before),
+			Series:     h2h.Record.Summary,
+			Meetings:   h2h.Meetings,
+			Prediction: game.Prediction,
+		}
+		if game.Prediction != nil {
+			preview.PredictedWinner = preview.Away.Name
+			if game.Prediction.HomeWinProbability >= 0.5 {
+				preview.PredictedWinner = preview.Home.Name
+			}
+		}
+		previews = append(previews, preview)
+	}
+	return previews
+}
+
+// teamPreview summarizes a team's season and recent form before date
+func teamPreview(league nba.League, team nba.Team, home bool, date string, history []nba.Game) TeamPreview {
+	code := strings.ToUpper(team.Code)
+	preview := TeamPreview{Code: code, Name: team.Name, VenueLabel: "Road", RestDays: -1}
+	if info, ok := nba.LookupTeam(league, code); ok {
+		preview.Name = info.Name
+	}
+	if home {
+		preview.VenueLabel = "Home"
+	}
+
+	gameLog := nba.BuildTeamGameLog(code, history)
+	preview.Record = gameLog.Record
+	preview.Streak = gameLog.Streak
+
+	venueWins, venueLosses := 0, 0
+	for _, entry := range gameLog.Games {
+		if entry.Home != home {
+			continue
+		}
+		if entry.Result == "W" {
+			venueWins++
+		} else {
+			venueLosses++
+		}
+	}
+	preview.Venue = fmt.Sprintf("%d-%d", venueWins, venueLosses)
+
+	gameLog.Last(formGames)
+	preview.Form = gameLog.Form
+	wins := strings.Count(gameLog.Form, "W")
+	preview.LastTen = fmt.Sprintf("%d-%d", wins, len(gameLog.Form)-wins)
+
+	// Scheduled and postponed games still count towards rest
+	for _, game := range history {
+		if game.HasTeam(code) && game.Date > preview.LastGame {
+			preview.LastGame = game.Date
+		}
+	}
+	if preview.LastGame != "" {
+		last, errLast := time.Parse("2006-01-02", preview.LastGame)
+		next, errNext := time.Parse("2006-01-02", date)
+		if errLast == nil && errNext == nil {
+			preview.RestDays = int(next.Sub(last).Hours()/24) - 1
+			preview.BackToBack = preview.RestDays == 0
+		}
+	}
+	return preview
+}
+
+// gamesBefore returns the games played before date
+func gamesBefore(games []nba.Game, date string) []nba.Game {
+	var before []nba.Game
+	for _, game := range games {
+		if game.Date < date {
+			before = append(before, game)
+		}
+	}
+	return before
+}
@@ -0,0 +1,87 @@
+package preview
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func game(date, home string, homeScore int, away string, awayScore int, status string) nba.Game {
+	return nba.Game{
+		GameID:   date + home,
+		Date:     date,
+		HomeTeam: nba.Team{Code: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Score: awayScore},
+		Status:   status,
+	}
+}
+
+func TestBuildPreviews(t *testing.T) {
+	history := []nba.Game{
+		game("2024-01-02", "LAL", 110, "BOS", 100, "Final"),
+		game("2024-01-05", "BOS", 120, "LAL", 111, "Final"),
+		game("2024-01-06", "BOS", 101, "MIA", 99, "Final"),
+		game("2024-01-08", "LAL", 99, "PHX", 104, "Final"),
+		game("2024-01-09", "GSW", 0, "BOS", 0, "Scheduled"),
+		game("2024-01-20", "LAL", 0, "DEN", 0, "Scheduled"), // After the previewed game
+	}
+	scheduled := game("2024-01-10", "LAL", 0, "BOS", 0, "Scheduled")
+	scheduled.Prediction = &nba.Prediction{HomeWinProbability: 0.58, AwayWinProbability: 0.42, ExpectedMargin: 2.5, PredictedWinner: "LAL"}
+	games := []nba.Game{scheduled, game("2024-01-09", "MIA", 95, "NYK", 90, "Final")}
+
+	previews := BuildPreviews(nba.NBA, games, history)
+
+	require.Len(t, previews, 1, "only scheduled games are previewed")
+	p := previews[0]
+	assert.Equal(t, "Boston Celtics @ Los Angeles Lakers", p.Matchup())
+	assert.Equal(t, "Series tied 1-1", p.Series)
+	assert.Len(t, p.Meetings, 2)
+	assert.Equal(t, "Los Angeles Lakers", p.PredictedWinner)
+
+	assert.Equal(t, "1-2", p.Home.Record)
+	assert.Equal(t, "1-1", p.Home.Venue, "home record")
+	assert.Equal(t, "WLL", p.Home.Form)
+	assert.Equal(t, "1-2", p.Home.LastTen)
+	assert.Equal(t, "L2", p.Home.Streak)
+	assert.Equal(t, 1, p.Home.RestDays)
+	assert.Equal(t, "1 day", p.Home.Rest())
+
+	assert.Equal(t, "2-1", p.Away.Record)
+	assert.Equal(t, "0-1", p.Away.Venue, "road record")
+	assert.Equal(t, "2024-01-09", p.Away.LastGame, "scheduled games count towards rest")
+	assert.True(t, p.Away.BackToBack)
+	assert.Equal(t, "back-to-back", p.Away.Rest())
+}
+
+func TestFirstGameRest(t *testing.T) {
+	previews := BuildPreviews(nba.NBA, []nba.Game{game("2024-10-22", "BOS", 0, "NYK", 0, "Scheduled")}, nil)
+
+	require.Len(t, previews, 1)
+	assert.Equal(t, -1, previews[0].Home.RestDays)
+	assert.Equal(t, "-", previews[0].Home.Rest())
+	assert.Equal(t, "0-0", previews[0].Home.Record)
+	assert.Equal(t, "No meetings", previews[0].Series)
+}
+
+func TestRender(t *testing.T) {
+	history := []nba.Game{game("2024-01-02", "LAL", 110, "BOS", 100, "Final")}
+	scheduled := game("2024-01-10", "LAL", 0, "BOS", 0, "Scheduled")
+	scheduled.Prediction = &nba.Prediction{HomeWinProbability: 0.58, ExpectedMargin: 2.5}
+	previews := BuildPreviews(nba.NBA, []nba.Game{scheduled}, history)
+	previews[0].Home.Name = "Lakers <LA>"
+
+	markdown, err := Markdown(previews)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "| Record | 0-1 | 1-0 |")
+	assert.Contains(t, markdown, "**Season series:** LAL leads 1-0")
+	assert.Contains(t, markdown, "- 2024-01-02: BOS 100, LAL 110")
+	assert.Contains(t, markdown, "58% home win, expected margin +2.5")
+
+	html, err := HTML(previews)
+	require.NoError(t, err)
+	assert.Contains(t, html, "<td>Record</td><td>0-1</td><td>1-0</td>")
+	assert.Contains(t, html, "Lakers &lt;LA&gt;")
+	assert.NotContains(t, html, "<LA>")
+}
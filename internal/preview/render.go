@@ -0,0 +1,92 @@
+package preview
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+)
+
+const markdownTemplate = `# Game Previews
+{{range .}}
+## {{.Matchup}}
+{{.Date}} {{.Time}}
+
+| | {{.Away.Code}} | {{.Home.Code}} |
+|---|---|---|
+| Record | {{.Away.Record}} | {{.Home.Record}} |
+| Home/Road | {{.Away.Venue}} road | {{.Home.Venue}} home |
+| Last 10 | {{.Away.LastTen}} {{.Away.Form}} | {{.Home.LastTen}} {{.Home.Form}} |
+| Streak | {{or .Away.Streak "-"}} | {{or .Home.Streak "-"}} |
+| Rest | {{.Away.Rest}} | {{.Home.Rest}} |
+
+**Season series:** {{.Series}}{{range .Meetings}}
+- {{.Date}}: {{.AwayTeam.Code}} {{.AwayTeam.Score}}, {{.HomeTeam.Code}} {{.HomeTeam.Score}}{{end}}
+{{if .Prediction}}
+**Prediction:** {{.PredictedWinner}} ({{percent .Prediction.HomeWinProbability}} home win, expected margin {{printf "%+.1f" .Prediction.ExpectedMargin}})
+{{end}}{{end}}`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Game Previews</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+.card { border: 1px solid #ccc; border-radius: 6px; padding: 1em; margin-bottom: 1.5em; max-width: 40em; }
+.card h2 { margin-top: 0; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 4px 8px; border-bottom: 1px solid #eee; }
+th { background: #4472C4; color: #fff; }
+.form { font-family: monospace; }
+</style>
+</head>
+<body>
+<h1>Game Previews</h1>
+{{range .}}<div class="card">
+<h2>{{.Matchup}}</h2>
+<p>{{.Date}} {{.Time}}</p>
+<table>
+<tr><th></th><th>{{.Away.Code}}</th><th>{{.Home.Code}}</th></tr>
+<tr><td>Record</td><td>{{.Away.Record}}</td><td>{{.Home.Record}}</td></tr>
+<tr><td>Home/Road</td><td>{{.Away.Venue}} road</td><td>{{.Home.Venue}} home</td></tr>
+<tr><td>Last 10</td><td>{{.Away.LastTen}} <span class="form">{{.Away.Form}}</span></td><td>{{.Home.LastTen}} <span class="form">{{.Home.Form}}</span></td></tr>
+<tr><td>Streak</td><td>{{or .Away.Streak "-"}}</td><td>{{or .Home.Streak "-"}}</td></tr>
+<tr><td>Rest</td><td>{{.Away.Rest}}</td><td>{{.Home.Rest}}</td></tr>
+</table>
+<p><strong>Season series:</strong> {{.Series}}</p>
+{{if .Meetings}}<ul>
+{{range .Meetings}}<li>{{.Date}}: {{.AwayTeam.Code}} {{.AwayTeam.Score}}, {{.HomeTeam.Code}} {{.HomeTeam.Score}}</li>
+{{end}}</ul>
+{{end}}{{if .Prediction}}<p><strong>Prediction:</strong> {{.PredictedWinner}} ({{percent .Prediction.HomeWinProbability}} home win, expected margin {{printf "%+.1f" .Prediction.ExpectedMargin}})</p>
+{{end}}</div>
+{{end}}</body>
+</html>
+`
+
+func percent(probability float64) string {
+	return fmt.Sprintf("%.0f%%", probability*100)
+}
+
+var (
+	markdown = template.Must(template.New("markdown").Funcs(template.FuncMap{"percent": percent}).Parse(markdownTemplate))
+	html     = htmltemplate.Must(htmltemplate.New("html").Funcs(htmltemplate.FuncMap{"percent": percent}).Parse(htmlTemplate))
+)
+
+// Markdown renders the previews as Markdown cards
+func Markdown(previews []Preview) (string, error) {
+	var out strings.Builder
+	if err := markdown.Execute(&out, previews); err != nil {
+		return "", fmt.Errorf("rendering Markdown previews: %w", err)
+	}
+	return out.String(), nil
+}
+
+// HTML renders the previews as a standalone HTML page of cards
+func HTML(previews []Preview) (string, error) {
+	var out strings.Builder
+	if err := html.Execute(&out, previews); err != nil {
+		return "", fmt.Errorf("rendering HTML previews: %w", err)
+	}
+	return out.String(), nil
+}
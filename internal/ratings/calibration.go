@@ -0,0 +1,101 @@
+package ratings
+
+import "math"
+
+// DefaultCalibrationBuckets is the number of reliability buckets in a calibration report
+const DefaultCalibrationBuckets = 10
+
+// CalibrationReport measures how well forecasts matched results
+type CalibrationReport struct {
+	Games         int                 `json:"games"`
+	BrierScore    float64             `json:"brier_score"`
+	LogLoss       float64             `json:"log_loss"`
+	Accuracy      float64             `json:"accuracy"`
+	MarginMAE     float64             `json:"margin_mae"` // Mean absolute error of the expected margin
+	BaselineBrier float64             `json:"baseline_brier"`
+	Reliability   []ReliabilityBucket `json:"reliability"`
+}
+
+// ReliabilityBucket compares the forecast home win probability with how often
+// the home team actually won, for forecasts within [Lower, Upper)
+type ReliabilityBucket struct {
+	Lower         float64 `json:"lower"`
+	Upper         float64 `json:"upper"`
+	Games         int     `json:"games"`
+	MeanPredicted float64 `json:"mean_predicted"`
+	ObservedRate  float64 `json:"observed_rate"`
+}
+
+// Calibrate scores forecasts against results using the Brier score, log loss
+// and reliability buckets of equal width
+func Calibrate(outcomes []PredictionOutcome, buckets int) *CalibrationReport {
+	if buckets <= 0 {
+		buckets = DefaultCalibrationBuckets
+	}
+
+	report := &CalibrationReport{
+		Games:       len(outcomes),
+		Reliability: make([]ReliabilityBucket, buckets),
+	}
+	for i := range report.Reliability {
+		report.Reliability[i].Lower = float64(i) / float64(buckets)
+		report.Reliability[i].Upper = float64(i+1) / float64(buckets)
+	}
+	if len(outcomes) == 0 {
+		return report
+	}
+
+	// Baseline: always forecast the observed home win rate
+	homeWins := 0.0
+	for _, outcome := range outcomes {
+		if outcome.HomeWon {
+			homeWins++
+		}
+	}
+	baseRate := homeWins / float64(len(outcomes))
+
+	const epsilon = 1e-15
+	var brier, logLoss, baseline, marginError, correct float64
+	for _, outcome := range outcomes {
+		p := outcome.Prediction.HomeWinProbability
+		actual := 0.0
+		if outcome.HomeWon {
+			actual = 1
+		}
+
+		brier += (p - actual) * (p - actual)
+		baseline += (baseRate - actual) * (baseRate - actual)
+		clamped := math.Min(math.Max(p, epsilon), 1-epsilon)
+		logLoss -= actual*math.Log(clamped) + (1-actual)*math.Log(1-clamped)
+		marginError += math.Abs(outcome.Prediction.ExpectedMargin - float64(outcome.ActualMargin))
+		if (p >= 0.5) == outcome.HomeWon {
+			correct++
+		}
+
+		index := int(p * float64(buckets))
+		if index >= buckets {
+			index = buckets - 1
+		}
+		bucket := &report.Reliability[index]
+		bucket.Games++
+		bucket.MeanPredicted += p
+		bucket.ObservedRate += actual
+	}
+
+	n := float64(len(outcomes))
+	report.BrierScore = brier / n
+	report.BaselineBrier = baseline / n
+	report.LogLoss = logLoss / n
+	report.MarginMAE = marginError / n
+	report.Accuracy = correct / n
+
+	for i := range report.Reliability {
+		bucket := &report.Reliability[i]
+		if bucket.Games > 0 {
+			bucket.MeanPredicted /= float64(bucket.Games)
+			bucket.ObservedRate /= float64(bucket.Games)
+		}
+	}
+
+	return report
+}
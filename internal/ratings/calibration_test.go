@@ -0,0 +1,46 @@
+package ratings
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func outcome(probability float64, homeWon bool) PredictionOutcome {
+	return PredictionOutcome{
+		Prediction: nba.Prediction{HomeWinProbability: probability, AwayWinProbability: 1 - probability},
+		HomeWon:    homeWon,
+	}
+}
+
+func TestCalibrate(t *testing.T) {
+	report := Calibrate([]PredictionOutcome{
+		outcome(0.8, true),
+		outcome(0.8, false),
+		outcome(0.3, false),
+		outcome(1, true),
+	}, 10)
+
+	assert.Equal(t, 4, report.Games)
+	assert.InDelta(t, (0.04+0.64+0.09+0)/4, report.BrierScore, 1e-9)
+	assert.InDelta(t, -(math.Log(0.8)+math.Log(0.2)+math.Log(0.7)+math.Log(1-1e-15))/4, report.LogLoss, 1e-9)
+	assert.InDelta(t, 0.75, report.Accuracy, 1e-9)
+	assert.InDelta(t, 0.25, report.BaselineBrier, 1e-9)
+
+	require.Len(t, report.Reliability, 10)
+	assert.Equal(t, 2, report.Reliability[8].Games)
+	assert.InDelta(t, 0.8, report.Reliability[8].MeanPredicted, 1e-9)
+	assert.InDelta(t, 0.5, report.Reliability[8].ObservedRate, 1e-9)
+	assert.Equal(t, 1, report.Reliability[3].Games)
+	assert.Equal(t, 1, report.Reliability[9].Games, "a certain forecast falls in the top bucket")
+}
+
+func TestCalibrate_Empty(t *testing.T) {
+	report := Calibrate(nil, 0)
+
+	assert.Zero(t, report.Games)
+	assert.Len(t, report.Reliability, DefaultCalibrationBuckets)
+}
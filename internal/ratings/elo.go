@@ -0,0 +1,243 @@
+package ratings
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// EloConfig holds the tuning parameters of the Elo engine
+type EloConfig struct {
+	InitialRating   float64 `json:"initial_rating"`
+	KFactor         float64 `json:"k_factor"`
+	HomeAdvantage   float64 `json:"home_advantage"`    // Elo points added to the home team
+	MarginOfVictory bool    `json:"margin_of_victory"` // Scale updates by the final margin
+	SeasonCarryOver float64 `json:"season_carry_over"` // Share of a rating's distance from the mean kept between seasons
+}
+
+// DefaultEloConfig returns parameters that work well for NBA results
+func DefaultEloConfig() EloConfig {
+	return EloConfig{
+		InitialRating:   1500,
+		KFactor:         20,
+		HomeAdvantage:   100,
+		MarginOfVictory: true,
+		SeasonCarryOver: 0.75,
+	}
+}
+
+// RatingPoint is a team's rating after one game
+type RatingPoint struct {
+	Date     string  `json:"date"`
+	Season   string  `json:"season"`
+	GameID   string  `json:"game_id"`
+	Opponent string  `json:"opponent"`
+	Result   string  `json:"result"` // W or L
+	Rating   float64 `json:"rating"`
+	Change   float64 `json:"change"`
+}
+
+// PowerRanking is a team's place in the current ratings
+type PowerRanking struct {
+	Rank   int     `json:"rank"`
+	Team   string  `json:"team"`
+	Name   string  `json:"name,omitempty"`
+	Rating float64 `json:"rating"`
+	Wins   int     `json:"wins"`
+	Losses int     `json:"losses"`
+	WinPct float64 `json:"win_pct"`
+}
+
+// RatingsReport is a snapshot of the engine's ratings for export
+type RatingsReport struct {
+	Period   string                   `json:"period"`
+	Config   EloConfig                `json:"config"`
+	Rankings []PowerRanking           `json:"rankings"`
+	History  map[string][]RatingPoint `json:"history"`
+}
+
+// EloEngine maintains Elo ratings as games are processed in date order
+type EloEngine struct {
+	league  nba.League
+	config  EloConfig
+	season  string
+	ratings map[string]float64
+	wins    map[string]int
+	losses  map[string]int
+	history map[string][]RatingPoint
+	results []PredictionOutcome
+}
+
+// NewEloEngine creates a new Elo engine for a league
+func NewEloEngine(league nba.League, config EloConfig) *EloEngine {
+	return &EloEngine{
+		league:  league,
+		config:  config,
+		ratings: make(map[string]float64),
+		wins:    make(map[string]int),
+		losses:  make(map[string]int),
+		history: make(map[string][]RatingPoint),
+	}
+}
+
+// Config returns the engine's parameters
+func (e *EloEngine) Config() EloConfig {
+	return e.config
+}
+
+// Process updates the ratings with the completed games in games, in date
+// order. Games must not be older than games already processed.
+func (e *EloEngine) Process(games []nba.Game) {
+	sorted := append([]nba.Game(nil), games...)
+	nba.SortGames(sorted)
+
+	for _, game := range sorted {
+		winner, ok := game.Winner()
+		if !ok {
+			continue
+		}
+		e.startSeason(game.Date)
+
+		home, away := strings.ToUpper(game.HomeTeam.Code), strings.ToUpper(game.AwayTeam.Code)
+		homeRating, awayRating := e.Rating(home), e.Rating(away)
+		e.results = append(e.results, PredictionOutcome{
+			Prediction:   e.Predict(game),
+			GameID:       game.GameID,
+			Date:         game.Date,
+			HomeTeam:     home,
+			AwayTeam:     away,
+			HomeWon:      strings.EqualFold(winner.Code, home),
+			ActualMargin: game.HomeTeam.Score - game.AwayTeam.Score,
+		})
+
+		homeWon := strings.EqualFold(winner.Code, home)
+		actual := 0.0
+		if homeWon {
+			actual = 1
+		}
+
+		shift := e.config.KFactor * (actual - e.WinProbability(home, away))
+		if e.config.MarginOfVictory {
+			// Winner's rating edge including home court, as used by the
+			// FiveThirtyEight NBA model to damp autocorrelation
+			edge := homeRating + e.config.HomeAdvantage - awayRating
+			if !homeWon {
+				edge = -edge
+			}
+			shift *= math.Pow(float64(game.Margin())+3, 0.8) / (7.5 + 0.006*edge)
+		}
+
+		e.ratings[home] = homeRating + shift
+		e.ratings[away] = awayRating - shift
+		e.record(home, away, homeWon, shift, game)
+		e.record(away, home, !homeWon, -shift, game)
+	}
+}
+
+// startSeason regresses every rating toward the mean when date belongs to a new season
+func (e *EloEngine) startSeason(date string) {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return
+	}
+	season := e.league.SeasonForDate(parsed)
+	if e.season != "" && season != e.season {
+		mean := e.config.InitialRating
+		for team, rating := range e.ratings {
+			e.ratings[team] = mean + e.config.SeasonCarryOver*(rating-mean)
+		}
+	}
+	e.season = season
+}
+
+// record updates a team's record and rating history after a game
+func (e *EloEngine) record(team, opponent string, won bool, change float64, game nba.Game) {
+	result := "L"
+	if won {
+		result = "W"
+		e.wins[team]++
+	} else {
+		e.losses[team]++
+	}
+
+	e.history[team] = append(e.history[team], RatingPoint{
+		Date:     game.Date,
+		Season:   e.season,
+		GameID:   game.GameID,
+		Opponent: opponent,
+		Result:   result,
+		Rating:   e.ratings[team],
+		Change:   change,
+	})
+}
+
+// Rating returns a team's current rating
+func (e *EloEngine) Rating(team string) float64 {
+	if rating, ok := e.ratings[strings.ToUpper(team)]; ok {
+		return rating
+	}
+	return e.config.InitialRating
+}
+
+// WinProbability returns the probability that the home team beats the away team
+func (e *EloEngine) WinProbability(home, away string) float64 {
+	diff := e.Rating(home) + e.config.HomeAdvantage - e.Rating(away)
+	return 1 / (1 + math.Pow(10, -diff/400))
+}
+
+// History returns every team's rating history keyed by team code
+func (e *EloEngine) History() map[string][]RatingPoint {
+	return e.history
+}
+
+// Rankings returns every team in the league ordered by current rating
+func (e *EloEngine) Rankings() []PowerRanking {
+	teams := make(map[string]string)
+	for _, team := range nba.Teams(e.league) {
+		teams[team.Code] = team.Name
+	}
+	for code := range e.ratings {
+		if _, ok := teams[code]; !ok {
+			teams[code] = ""
+		}
+	}
+
+	rankings := make([]PowerRanking, 0, len(teams))
+	for code, name := range teams {
+		ranking := PowerRanking{
+			Team:   code,
+			Name:   name,
+			Rating: e.Rating(code),
+			Wins:   e.wins[code],
+			Losses: e.losses[code],
+		}
+		if played := ranking.Wins + ranking.Losses; played > 0 {
+			ranking.WinPct = float64(ranking.Wins) / float64(played)
+		}
+		rankings = append(rankings, ranking)
+	}
+
+	sort.Slice(rankings, func(i, j int) bool {
+		if rankings[i].Rating != rankings[j].Rating {
+			return rankings[i].Rating > rankings[j].Rating
+		}
+		return rankings[i].Team < rankings[j].Team
+	})
+	for i := range rankings {
+		rankings[i].Rank = i + 1
+	}
+	return rankings
+}
+
+// Report returns the current rankings and rating histories
+func (e *EloEngine) Report(period string) *RatingsReport {
+	return &RatingsReport{
+		Period:   period,
+		Config:   e.config,
+		Rankings: e.Rankings(),
+		History:  e.history,
+	}
+}
@@ -0,0 +1,94 @@
+package ratings
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// final builds a completed game between home and away on date
+func final(date, home string, homeScore int, away string, awayScore int) nba.Game {
+	return nba.Game{
+		GameID:   date + home + away,
+		Date:     date,
+		HomeTeam: nba.Team{Code: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Score: awayScore},
+		Status:   "Final",
+		Quarter:  4,
+	}
+}
+
+func TestEloEngine_WinProbability(t *testing.T) {
+	engine := NewEloEngine(nba.NBA, DefaultEloConfig())
+
+	// Equal teams: only home court separates them
+	assert.InDelta(t, 0.64, engine.WinProbability("LAL", "BOS"), 0.01)
+
+	config := DefaultEloConfig()
+	config.HomeAdvantage = 0
+	assert.InDelta(t, 0.5, NewEloEngine(nba.NBA, config).WinProbability("LAL", "BOS"), 0.0001)
+}
+
+func TestEloEngine_Process(t *testing.T) {
+	config := DefaultEloConfig()
+	config.MarginOfVictory = false
+	engine := NewEloEngine(nba.NBA, config)
+
+	engine.Process([]nba.Game{
+		final("2024-01-02", "BOS", 100, "LAL", 90),
+		final("2024-01-01", "LAL", 110, "BOS", 100),
+	})
+
+	// Ratings stay zero-sum
+	assert.InDelta(t, 3000, engine.Rating("LAL")+engine.Rating("BOS"), 0.0001)
+
+	history := engine.History()["LAL"]
+	require.Len(t, history, 2)
+	assert.Equal(t, "2024-01-01", history[0].Date)
+	assert.Equal(t, "W", history[0].Result)
+	// Home favourite gains less than K/2 for the expected win
+	assert.InDelta(t, 20*(1-0.64), history[0].Change, 0.1)
+	assert.Equal(t, "L", history[1].Result)
+	assert.Less(t, history[1].Change, 0.0)
+}
+
+func TestEloEngine_MarginOfVictory(t *testing.T) {
+	close := NewEloEngine(nba.NBA, DefaultEloConfig())
+	close.Process([]nba.Game{final("2024-01-01", "LAL", 101, "BOS", 100)})
+
+	blowout := NewEloEngine(nba.NBA, DefaultEloConfig())
+	blowout.Process([]nba.Game{final("2024-01-01", "LAL", 130, "BOS", 100)})
+
+	assert.Greater(t, blowout.Rating("LAL"), close.Rating("LAL"))
+}
+
+func TestEloEngine_SeasonRegression(t *testing.T) {
+	config := DefaultEloConfig()
+	config.MarginOfVictory = false
+	engine := NewEloEngine(nba.NBA, config)
+
+	engine.Process([]nba.Game{final("2024-04-01", "LAL", 110, "BOS", 100)})
+	before := engine.Rating("LAL")
+
+	engine.Process([]nba.Game{final("2024-10-25", "MIA", 110, "CHI", 100)})
+	assert.InDelta(t, 1500+0.75*(before-1500), engine.Rating("LAL"), 0.0001)
+}
+
+func TestEloEngine_Rankings(t *testing.T) {
+	engine := NewEloEngine(nba.NBA, DefaultEloConfig())
+	engine.Process([]nba.Game{
+		final("2024-01-01", "LAL", 110, "BOS", 100),
+		final("2024-01-02", "LAL", 110, "MIA", 100),
+	})
+
+	rankings := engine.Rankings()
+	require.Len(t, rankings, 30)
+	assert.Equal(t, 1, rankings[0].Rank)
+	assert.Equal(t, "LAL", rankings[0].Team)
+	assert.Equal(t, "Los Angeles Lakers", rankings[0].Name)
+	assert.Equal(t, 2, rankings[0].Wins)
+	assert.Equal(t, 1.0, rankings[0].WinPct)
+	assert.Contains(t, []string{"BOS", "MIA"}, rankings[29].Team)
+}
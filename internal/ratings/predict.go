@@ -0,0 +1,57 @@
+package ratings
+
+import (
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// eloPointsPerPoint converts a rating difference into an expected points
+// margin; 28 Elo points is worth about one point on the scoreboard
+const eloPointsPerPoint = 28.0
+
+// PredictionOutcome pairs the forecast made before a game with its result
+type PredictionOutcome struct {
+	Prediction   nba.Prediction `json:"prediction"`
+	GameID       string         `json:"game_id"`
+	Date         string         `json:"date"`
+	HomeTeam     string         `json:"home_team"`
+	AwayTeam     string         `json:"away_team"`
+	HomeWon      bool           `json:"home_won"`
+	ActualMargin int            `json:"actual_margin"` // Home points minus away points
+}
+
+// Predict forecasts a game from the current ratings
+func (e *EloEngine) Predict(game nba.Game) nba.Prediction {
+	home, away := strings.ToUpper(game.HomeTeam.Code), strings.ToUpper(game.AwayTeam.Code)
+	probability := e.WinProbability(home, away)
+
+	winner := home
+	if probability < 0.5 {
+		winner = away
+	}
+
+	return nba.Prediction{
+		HomeWinProbability: probability,
+		AwayWinProbability: 1 - probability,
+		ExpectedMargin:     (e.Rating(home) + e.config.HomeAdvantage - e.Rating(away)) / eloPointsPerPoint,
+		PredictedWinner:    winner,
+		Model:              "elo",
+	}
+}
+
+// PredictGames attaches a forecast to every scheduled game in games
+func (e *EloEngine) PredictGames(games []nba.Game) {
+	for i := range games {
+		if games[i].Status == "Scheduled" {
+			prediction := e.Predict(games[i])
+			games[i].Prediction = &prediction
+		}
+	}
+}
+
+// Outcomes returns the forecast made before every processed game, in the
+// order the games were processed
+func (e *EloEngine) Outcomes() []PredictionOutcome {
+	return e.results
+}
@@ -0,0 +1,52 @@
+package ratings
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEloEngine_Predict(t *testing.T) {
+	config := DefaultEloConfig()
+	config.HomeAdvantage = 0
+	engine := NewEloEngine(nba.NBA, config)
+	engine.Process([]nba.Game{final("2024-01-02", "BOS", 110, "LAL", 90)})
+
+	games := []nba.Game{
+		{GameID: "1", HomeTeam: nba.Team{Code: "LAL"}, AwayTeam: nba.Team{Code: "BOS"}, Status: "Scheduled"},
+		final("2024-01-05", "LAL", 100, "BOS", 95),
+	}
+	engine.PredictGames(games)
+
+	require.NotNil(t, games[0].Prediction)
+	prediction := games[0].Prediction
+	assert.Equal(t, "BOS", prediction.PredictedWinner)
+	assert.Equal(t, "elo", prediction.Model)
+	assert.Less(t, prediction.HomeWinProbability, 0.5)
+	assert.InDelta(t, 1, prediction.HomeWinProbability+prediction.AwayWinProbability, 1e-9)
+	assert.Less(t, prediction.ExpectedMargin, 0.0)
+
+	assert.Nil(t, games[1].Prediction, "completed games are not predicted")
+}
+
+func TestEloEngine_Outcomes(t *testing.T) {
+	engine := NewEloEngine(nba.NBA, DefaultEloConfig())
+	engine.Process([]nba.Game{
+		final("2024-01-03", "LAL", 90, "BOS", 100),
+		final("2024-01-02", "BOS", 100, "LAL", 90),
+	})
+
+	outcomes := engine.Outcomes()
+	require.Len(t, outcomes, 2)
+
+	// The first game is forecast before any rating has moved
+	assert.Equal(t, "2024-01-02", outcomes[0].Date)
+	assert.InDelta(t, 0.64, outcomes[0].Prediction.HomeWinProbability, 0.01)
+	assert.True(t, outcomes[0].HomeWon)
+	assert.Equal(t, 10, outcomes[0].ActualMargin)
+
+	assert.False(t, outcomes[1].HomeWon)
+	assert.Equal(t, -10, outcomes[1].ActualMargin)
+}
@@ -0,0 +1,215 @@
+package recap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+// Notability weights added to a game's watchability when ranking the digest
+const (
+	streakWeight    = 5.0  // Per game of a streak beyond two, for the winner's streak or a snapped one
+	maxStreakBonus  = 25.0 // Cap on the streak bonus
+	milestoneWeight = 15.0 // Per milestone reached in the game
+	blowoutWeight   = 10.0 // A winning margin of blowoutMargin or more is notable in its own right
+	blowoutMargin   = 25
+	featWeight      = 5.0  // Per notable individual performance in the game
+	maxFeatBonus    = 15.0 // Cap on the feat bonus
+)
+
+// TeamLine is one side of a recapped game
+type TeamLine struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	City     string `json:"city"`
+	Nickname string `json:"nickname"`
+	Score    int    `json:"score"`
+	Wins     int    `json:"wins"`   // Record after the game
+	Losses   int    `json:"losses"` // Record after the game
+	// Streak is the team's streak after the game and PriorStreak the one it
+	// brought into the game
+	Streak      stats.Streak `json:"streak"`
+	PriorStreak stats.Streak `json:"prior_streak"`
+}
+
+// Record formats the team's record, e.g. "30-12"
+func (t TeamLine) Record() string {
+	return fmt.Sprintf("%d-%d", t.Wins, t.Losses)
+}
+
+// GameRecap holds the facts a recap sentence is written from. Winner and
+// Loser are set for completed games; Home and Away always are.
+type GameRecap struct {
+	GameID       string     `json:"game_id"`
+	Date         string     `json:"date"`
+	Status       string     `json:"status"`
+	Home         TeamLine   `json:"home"`
+	Away         TeamLine   `json:"away"`
+	Winner       TeamLine   `json:"winner"`
+	Loser        TeamLine   `json:"loser"`
+	WinnerHome   bool       `json:"winner_home"`
+	Margin       int        `json:"margin"`
+	Overtimes    int        `json:"overtimes"`
+	Watchability float64    `json:"watchability"`
+	Milestones   []string   `json:"milestones,omitempty"`
+	Feats        []nba.Feat `json:"feats,omitempty"`
+	Notability   float64    `json:"notability"`
+	Headline     string     `json:"headline"` // Rendered from the game template
+}
+
+// Digest is the ranked recap of a day's completed games
+type Digest struct {
+	Date        string      `json:"date"`
+	League      string      `json:"league"`
+	SpoilerFree bool        `json:"spoiler_free,omitempty"`
+	Games       []GameRecap `json:"games"`
+	Scheduled   int         `json:"scheduled"` // Games on the date not yet final
+	// Performances are the feats of the day's games, in the order of the games
+	Performances []nba.Feat `json:"performances,omitempty"`
+}
+
+// BuildDigest recaps the completed games on date. Records and streaks are
+// computed from history, which should hold every game of the season up to
+// and including date. Games are ranked by notability, most notable first.
+func BuildDigest(league nba.League, date string, history []nba.Game) *Digest {
+	digest := &Digest{Date: date, League: league.Name}
+
+	var before, through []nba.Game
+	var today []nba.Game
+	for _, game := range history {
+		switch {
+		case game.Date < date:
+			before = append(before, game)
+			through = append(through, game)
+		case game.Date == date:
+			through = append(through, game)
+			today = append(today, game)
+		}
+	}
+
+	prior := streaksByTeam(before)
+	after := streaksByTeam(through)
+	milestones := make(map[string][]string)
+	for _, milestone := range stats.DetectMilestones(through, stats.DefaultWinMilestones) {
+		if milestone.Date == date && milestone.Kind == stats.MilestoneFirstToWins {
+			milestones[milestone.Team] = append(milestones[milestone.Team], milestone.Description)
+		}
+	}
+
+	nba.SortGames(today)
+	for _, game := range today {
+		winner, ok := game.Winner()
+		if !ok {
+			digest.Scheduled++
+			continue
+		}
+
+		recap := GameRecap{
+			GameID:     game.GameID,
+			Date:       game.Date,
+			Status:     game.Status,
+			Home:       teamLine(league, game.HomeTeam, prior, after),
+			Away:       teamLine(league, game.AwayTeam, prior, after),
+			WinnerHome: game.IsHome(winner.Code),
+			Margin:     game.Margin(),
+		}
+		if recap.WinnerHome {
+			recap.Winner, recap.Loser = recap.Home, recap.Away
+		} else {
+			recap.Winner, recap.Loser = recap.Away, recap.Home
+		}
+		if game.Watchability != nil {
+			recap.Watchability = game.Watchability.Score
+			recap.Overtimes = game.Watchability.Overtimes
+		} else if game.Quarter > 4 {
+			recap.Overtimes = game.Quarter - 4
+		}
+		recap.Milestones = append(recap.Milestones, milestones[game.HomeTeam.Code]...)
+		recap.Milestones = append(recap.Milestones, milestones[game.AwayTeam.Code]...)
+		recap.Feats = game.Feats
+		recap.Notability = notability(recap)
+
+		digest.Games = append(digest.Games, recap)
+	}
+
+	sort.SliceStable(digest.Games, func(i, j int) bool {
+		return digest.Games[i].Notability > digest.Games[j].Notability
+	})
+	for _, game := range digest.Games {
+		digest.Performances = append(digest.Performances, game.Feats...)
+	}
+	return digest
+}
+
+// HideSpoilers returns a copy of the digest with only each game's teams,
+// status and watchability, ranked by watchability alone
+func (d *Digest) HideSpoilers() *Digest {
+	hidden := &Digest{Date: d.Date, League: d.League, SpoilerFree: true, Scheduled: d.Scheduled}
+	for _, game := range d.Games {
+		hidden.Games = append(hidden.Games, GameRecap{
+			GameID:       game.GameID,
+			Date:         game.Date,
+			Status:       game.Status,
+			Home:         TeamLine{Code: game.Home.Code, Name: game.Home.Name, City: game.Home.City, Nickname: game.Home.Nickname},
+			Away:         TeamLine{Code: game.Away.Code, Name: game.Away.Name, City: game.Away.City, Nickname: game.Away.Nickname},
+			Watchability: game.Watchability,
+		})
+	}
+	sort.SliceStable(hidden.Games, func(i, j int) bool {
+		return hidden.Games[i].Watchability > hidden.Games[j].Watchability
+	})
+	return hidden
+}
+
+// notability scores how much a result stands out: its watchability plus
+// bonuses for streaks, milestones, feats and blowouts
+func notability(recap GameRecap) float64 {
+	streak := 0
+	if recap.Winner.Streak.Length > 2 {
+		streak = recap.Winner.Streak.Length - 2
+	}
+	if snapped := recap.Loser.PriorStreak; snapped.Result == "W" && snapped.Length-2 > streak {
+		streak = snapped.Length - 2
+	}
+
+	score := recap.Watchability + minFloat(streakWeight*float64(streak), maxStreakBonus)
+	score += milestoneWeight * float64(len(recap.Milestones))
+	score += minFloat(featWeight*float64(len(recap.Feats)), maxFeatBonus)
+	if recap.Margin >= blowoutMargin {
+		score += blowoutWeight
+	}
+	return score
+}
+
+// teamLine describes a team with its record and streaks around a game
+func teamLine(league nba.League, team nba.Team, prior, after map[string]stats.TeamStreaks) TeamLine {
+	line := TeamLine{Code: team.Code, Name: team.Name, City: team.Name, Nickname: team.Name, Score: team.Score}
+	if info, ok := nba.LookupTeam(league, team.Code); ok {
+		line.Name, line.City, line.Nickname = info.Name, info.City, info.Nickname
+	}
+
+	streaks := after[strings.ToUpper(team.Code)]
+	line.Wins, line.Losses = streaks.Wins, streaks.Losses
+	line.Streak = streaks.Overall.Current
+	line.PriorStreak = prior[strings.ToUpper(team.Code)].Overall.Current
+	return line
+}
+
+// streaksByTeam computes every team's streaks over games keyed by team code
+func streaksByTeam(games []nba.Game) map[string]stats.TeamStreaks {
+	byTeam := make(map[string]stats.TeamStreaks)
+	for _, team := range stats.ComputeStreaks(games) {
+		byTeam[strings.ToUpper(team.Team)] = team
+	}
+	return byTeam
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+package recap
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func final(date, home string, homeScore int, away string, awayScore int) nba.Game {
+	return nba.Game{
+		GameID:   date + home,
+		Date:     date,
+		HomeTeam: nba.Team{Code: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Score: awayScore},
+		Status:   "Final",
+	}
+}
+
+func TestBuildDigest(t *testing.T) {
+	history := []nba.Game{
+		final("2024-01-10", "MIL", 110, "DET", 100),
+		final("2024-01-11", "BOS", 120, "MIL", 115),
+		final("2024-01-12", "MIL", 118, "ORL", 101),
+		final("2024-01-13", "MIL", 99, "NYK", 90),
+		final("2024-01-14", "MIL", 125, "ATL", 111),
+		final("2024-01-10", "BOS", 101, "PHI", 99),
+		final("2024-01-12", "BOS", 105, "TOR", 100),
+		final("2024-01-13", "BOS", 112, "IND", 108),
+		final("2024-01-15", "CHI", 95, "MIL", 103),
+		final("2024-01-15", "LAL", 130, "BOS", 100),
+		{GameID: "late", Date: "2024-01-15", HomeTeam: nba.Team{Code: "GSW"}, AwayTeam: nba.Team{Code: "MIA"}, Status: "Scheduled"},
+	}
+	history[len(history)-2].Watchability = &nba.Watchability{Score: 20}
+
+	digest := BuildDigest(nba.NBA, "2024-01-15", history)
+
+	require.Len(t, digest.Games, 2)
+	assert.Equal(t, 1, digest.Scheduled)
+
+	lakers := digest.Games[0]
+	assert.Equal(t, "LAL", lakers.Winner.Code)
+	assert.Equal(t, 4, lakers.Loser.PriorStreak.Length)
+	assert.Equal(t, "W", lakers.Loser.PriorStreak.Result)
+	assert.Equal(t, 30, lakers.Margin)
+	assert.InDelta(t, 20+2*streakWeight+blowoutWeight, lakers.Notability, 0.001)
+
+	bucks := digest.Games[1]
+	assert.Equal(t, "MIL", bucks.Winner.Code)
+	assert.False(t, bucks.WinnerHome)
+	assert.Equal(t, "Bucks", bucks.Winner.Nickname)
+	assert.Equal(t, "5-1", bucks.Winner.Record())
+	assert.Equal(t, 4, bucks.Winner.Streak.Length)
+	assert.Equal(t, "0-1", bucks.Loser.Record())
+	assert.InDelta(t, 2*streakWeight, bucks.Notability, 0.001)
+}
+
+func TestRender(t *testing.T) {
+	history := []nba.Game{
+		final("2024-01-12", "MIL", 118, "ORL", 101),
+		final("2024-01-13", "MIL", 99, "NYK", 90),
+		final("2024-01-14", "MIL", 125, "ATL", 111),
+		final("2024-01-10", "BOS", 101, "PHI", 99),
+		final("2024-01-12", "BOS", 105, "TOR", 100),
+		final("2024-01-13", "BOS", 112, "IND", 108),
+		final("2024-01-15", "CHI", 95, "MIL", 103),
+		final("2024-01-15", "LAL", 130, "BOS", 124),
+	}
+	history[len(history)-1].Quarter = 5
+	history[len(history)-2].Feats = []nba.Feat{{
+		Tag:         "triple_double",
+		Description: "Giannis Antetokounmpo (MIL): triple-double @ CHI, 32 PTS, 14 REB, 11 AST (3rd of the season)",
+	}}
+	digest := BuildDigest(nba.NBA, "2024-01-15", history)
+	require.Len(t, digest.Performances, 1)
+
+	renderer, err := NewRenderer(FormatText, Templates{}, false)
+	require.NoError(t, err)
+	text, err := renderer.Render(digest)
+	require.NoError(t, err)
+
+	assert.Contains(t, text, "Bucks win at Bulls 103-95, their fourth straight (MIL 4-0, CHI 0-1)")
+	assert.Contains(t, text, "Lakers beat Celtics 130-124 in overtime, ending Boston's three-game winning streak")
+	assert.Contains(t, text, "Notable performances:\n  * Giannis Antetokounmpo (MIL): triple-double @ CHI")
+
+	renderer, err = NewRenderer(FormatMarkdown, Templates{}, true)
+	require.NoError(t, err)
+	text, err = renderer.Render(digest.HideSpoilers())
+	require.NoError(t, err)
+
+	assert.Contains(t, text, "Bucks at Bulls: Final, watchability 0/100")
+	assert.NotContains(t, text, "103")
+	assert.NotContains(t, text, "MIL 4-0")
+	assert.NotContains(t, text, "Notable performances")
+}
+
+func TestCustomTemplates(t *testing.T) {
+	digest := BuildDigest(nba.NBA, "2024-01-15", []nba.Game{final("2024-01-15", "CHI", 95, "MIL", 103)})
+
+	renderer, err := NewRenderer(FormatMarkdown, Templates{
+		Game:   `{{.Winner.Code}} over {{.Loser.Code}} by {{.Margin}}`,
+		Digest: `{{range .Games}}* {{.Headline}}{{end}}`,
+	}, false)
+	require.NoError(t, err)
+	text, err := renderer.Render(digest)
+	require.NoError(t, err)
+	assert.Equal(t, "* MIL over CHI by 8", text)
+
+	_, err = NewRenderer(FormatMarkdown, Templates{Game: `{{.Winner`}, false)
+	assert.Error(t, err)
+	_, err = NewRenderer("html", Templates{}, false)
+	assert.Error(t, err)
+}
+
+func TestOrdinal(t *testing.T) {
+	assert.Equal(t, "fifth", ordinal(5))
+	assert.Equal(t, "11th", ordinal(11))
+	assert.Equal(t, "21st", ordinal(21))
+	assert.Equal(t, "112th", ordinal(112))
+	assert.Equal(t, "Boston's", possessive("Boston"))
+	assert.Equal(t, "Los Angeles'", possessive("Los Angeles"))
+}
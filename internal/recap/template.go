@@ -0,0 +1,181 @@
+package recap
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Output formats
+const (
+	FormatMarkdown = "markdown"
+	FormatText     = "text"
+)
+
+// DefaultGameTemplate writes one sentence per completed game, e.g.
+// "Bucks win at Bulls 103-95, their fifth straight"
+const DefaultGameTemplate = `{{.Winner.Nickname}} {{if .WinnerHome}}beat{{else}}win at{{end}} {{.Loser.Nickname}} ` +
+	`{{.Winner.Score}}-{{.Loser.Score}}{{if .Overtimes}} in {{overtime .Overtimes}}{{end}}` +
+	`{{if ge .Winner.Streak.Length 3}}, their {{ordinal .Winner.Streak.Length}} straight` +
+	`{{else if and (eq .Loser.PriorStreak.Result "W") (ge .Loser.PriorStreak.Length 3)}}, ending {{possessive .Loser.City}} {{number .Loser.PriorStreak.Length}}-game winning streak` +
+	`{{else if and (eq .Winner.PriorStreak.Result "L") (ge .Winner.PriorStreak.Length 3)}}, snapping a {{number .Winner.PriorStreak.Length}}-game skid{{end}}`
+
+// SpoilerFreeGameTemplate names each game and its watchability without the result
+const SpoilerFreeGameTemplate = `{{.Away.Nickname}} at {{.Home.Nickname}}: {{.Status}}, watchability {{printf "%.0f" .Watchability}}/100`
+
+// DefaultMarkdownTemplate lays out the digest for a Markdown channel post
+const DefaultMarkdownTemplate = `## {{.League}} recap: {{.Date}}
+{{if not .Games}}
+No completed games.
+{{else}}
+{{range $i, $game := .Games}}{{inc $i}}. {{if $.SpoilerFree}}{{$game.Headline}}{{else}}**{{$game.Headline}}**` +
+	` ({{$game.Winner.Code}} {{$game.Winner.Record}}, {{$game.Loser.Code}} {{$game.Loser.Record}}){{end}}
+{{range $game.Milestones}}   - {{.}}
+{{end}}{{end}}{{end}}{{if .Performances}}
+### Notable performances
+
+{{range .Performances}}- {{.Description}}
+{{end}}{{end}}{{if .Scheduled}}
+_{{.Scheduled}} more {{plural .Scheduled "game" "games"}} not yet final._
+{{end}}`
+
+// DefaultTextTemplate lays out the digest as plain text
+const DefaultTextTemplate = `{{.League}} recap for {{.Date}}
+{{if not .Games}}No completed games.
+{{else}}{{range $i, $game := .Games}}{{inc $i}}. {{$game.Headline}}{{if not $.SpoilerFree}}` +
+	` ({{$game.Winner.Code}} {{$game.Winner.Record}}, {{$game.Loser.Code}} {{$game.Loser.Record}}){{end}}
+{{range $game.Milestones}}   * {{.}}
+{{end}}{{end}}{{end}}{{if .Performances}}
+Notable performances:
+{{range .Performances}}  * {{.Description}}
+{{end}}{{end}}{{if .Scheduled}}{{.Scheduled}} more {{plural .Scheduled "game" "games"}} not yet final.
+{{end}}`
+
+// Templates holds the template sources used to render recaps. Empty fields
+// fall back to the defaults for the output format.
+type Templates struct {
+	Game   string
+	Digest string
+}
+
+// Renderer renders game headlines and the digest from templates
+type Renderer struct {
+	game   *template.Template
+	digest *template.Template
+}
+
+// NewRenderer parses the templates for an output format; spoilerFree
+// selects the spoiler-free game template when no game template is given
+func NewRenderer(format string, templates Templates, spoilerFree bool) (*Renderer, error) {
+	if templates.Game == "" {
+		templates.Game = DefaultGameTemplate
+		if spoilerFree {
+			templates.Game = SpoilerFreeGameTemplate
+		}
+	}
+	if templates.Digest == "" {
+		switch format {
+		case FormatMarkdown:
+			templates.Digest = DefaultMarkdownTemplate
+		case FormatText:
+			templates.Digest = DefaultTextTemplate
+		default:
+			return nil, fmt.Errorf("unknown recap format %q: use %s or %s", format, FormatMarkdown, FormatText)
+		}
+	}
+
+	game, err := template.New("game").Funcs(funcs).Parse(templates.Game)
+	if err != nil {
+		return nil, fmt.Errorf("parsing game template: %w", err)
+	}
+	digest, err := template.New("digest").Funcs(funcs).Parse(templates.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("parsing digest template: %w", err)
+	}
+	return &Renderer{game: game, digest: digest}, nil
+}
+
+// Render writes each game's headline and then the whole digest
+func (r *Renderer) Render(digest *Digest) (string, error) {
+	for i := range digest.Games {
+		headline, err := r.Headline(digest.Games[i])
+		if err != nil {
+			return "", err
+		}
+		digest.Games[i].Headline = headline
+	}
+
+	var out strings.Builder
+	if err := r.digest.Execute(&out, digest); err != nil {
+		return "", fmt.Errorf("rendering digest: %w", err)
+	}
+	return out.String(), nil
+}
+
+// Headline renders the recap sentence of one game
+func (r *Renderer) Headline(game GameRecap) (string, error) {
+	var out strings.Builder
+	if err := r.game.Execute(&out, game); err != nil {
+		return "", fmt.Errorf("rendering recap of game %s: %w", game.GameID, err)
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+var numberWords = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten"}
+
+var ordinalWords = []string{"zeroth", "first", "second", "third", "fourth", "fifth", "sixth", "seventh", "eighth", "ninth", "tenth"}
+
+// funcs are the helpers available to recap templates
+var funcs = template.FuncMap{
+	"inc":        func(i int) int { return i + 1 },
+	"number":     number,
+	"ordinal":    ordinal,
+	"overtime":   overtime,
+	"possessive": possessive,
+	"plural": func(n int, singular, plural string) string {
+		if n == 1 {
+			return singular
+		}
+		return plural
+	},
+}
+
+// number spells out numbers up to ten, e.g. "five"
+func number(n int) string {
+	if n >= 0 && n < len(numberWords) {
+		return numberWords[n]
+	}
+	return fmt.Sprint(n)
+}
+
+// ordinal spells out ordinals up to tenth, then uses suffixes, e.g. "12th"
+func ordinal(n int) string {
+	if n >= 0 && n < len(ordinalWords) {
+		return ordinalWords[n]
+	}
+	return nba.Ordinal(n)
+}
+
+// overtime describes the number of overtimes, e.g. "double overtime"
+func overtime(n int) string {
+	switch n {
+	case 1:
+		return "overtime"
+	case 2:
+		return "double overtime"
+	case 3:
+		return "triple overtime"
+	default:
+		return fmt.Sprintf("%d overtimes", n)
+	}
+}
+
+// possessive forms the possessive of a name, e.g. "Boston's"
+func possessive(name string) string {
+	if strings.HasSuffix(name, "s") {
+		return name + "'"
+	}
+	return name + "'s"
+}
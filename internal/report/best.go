@@ -0,0 +1,83 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// GenerateBestGamesReport generates an Excel report ranking games by watchability
+func (r *ExcelReporter) GenerateBestGamesReport(games []nba.Game, period, filename string) error {
+	sheetName := "Best Games"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Best Games (%s)", period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	if r.spoilerFree {
+		if err := r.writeSpoilerFreeBestGames(sheetName, games); err != nil {
+			return err
+		}
+		return r.save(filename)
+	}
+
+	headers := []string{
+		"Rank", "Date", "Game ID", "Away Team", "Away Score", "Home Team", "Home Score",
+		"Watchability", "Margin", "Overtimes", "Lead Changes", "Ties", "Late Margin", "Source",
+	}
+	var rows [][]interface{}
+	for i, game := range games {
+		w := game.Watchability
+		rows = append(rows, []interface{}{
+			i + 1,
+			game.Date,
+			game.GameID,
+			game.AwayTeam.Name,
+			game.AwayTeam.Score,
+			game.HomeTeam.Name,
+			game.HomeTeam.Score,
+			w.Score,
+			w.FinalMargin,
+			w.Overtimes,
+			w.LeadChanges,
+			w.Ties,
+			w.LateMargin,
+			w.Source,
+		})
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding games: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 6, 12, 10, 22, 11, 22, 11, 13, 8, 10, 13, 6, 12, 14); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
+
+// writeSpoilerFreeBestGames lists the best games with only their watchability
+// score, leaving out scores and the margin, overtime and lead change details
+func (r *ExcelReporter) writeSpoilerFreeBestGames(sheetName string, games []nba.Game) error {
+	headers := []string{"Rank", "Date", "Game ID", "Away Team", "Home Team", "Watchability"}
+	var rows [][]interface{}
+	for i, game := range games {
+		rows = append(rows, []interface{}{
+			i + 1,
+			game.Date,
+			game.GameID,
+			game.AwayTeam.Name,
+			game.HomeTeam.Name,
+			game.Watchability.Score,
+		})
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding games: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 6, 12, 10, 22, 22, 13); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
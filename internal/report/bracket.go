@@ -0,0 +1,157 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/pool"
+)
+
+// GenerateBracketReport generates an Excel report of a bracket challenge's
+// leaderboard, each entry's picks and the series played so far
+func (r *ExcelReporter) GenerateBracketReport(results *pool.BracketReport, filename string) error {
+	if err := r.addBracketLeaderboardSheet(results); err != nil {
+		return err
+	}
+	if err := r.addBracketEntriesSheet(results); err != nil {
+		return err
+	}
+	if err := r.addBracketSeriesSheet(results); err != nil {
+		return err
+	}
+
+	// Open on the leaderboard
+	index, err := r.file.GetSheetIndex("Leaderboard")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addBracketLeaderboardSheet lists the entries with their points by round
+func (r *ExcelReporter) addBracketLeaderboardSheet(results *pool.BracketReport) error {
+	sheetName := "Leaderboard"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Bracket Challenge (%s)", results.Season)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	weights := make([]string, len(results.Config.Weights))
+	for i, weight := range results.Config.Weights {
+		weights[i] = fmt.Sprint(weight)
+	}
+	row, err := r.writeKeyValues(sheetName, 3, [][2]interface{}{
+		{"Round Weights", strings.Join(weights, ", ")},
+		{"Series Length Bonus", results.Config.GameBonus},
+		{"Tiebreakers", strings.Join(results.Tiebreakers, "; ")},
+	})
+	if err != nil {
+		return fmt.Errorf("adding scoring: %w", err)
+	}
+
+	headers := []string{"Rank", "Participant", "Points", "Remaining", "Max Points", "Correct", "Wrong", "Pending", "Lengths Right", "Champion"}
+	headers = append(headers, results.Rounds...)
+	var rows [][]interface{}
+	for _, entry := range results.Entries {
+		values := []interface{}{
+			entry.Rank,
+			entry.Participant,
+			entry.Points,
+			entry.Remaining,
+			entry.MaxPoints,
+			entry.Correct,
+			entry.Wrong,
+			entry.Pending,
+			entry.GamesCorrect,
+			entry.Champion,
+		}
+		for _, points := range entry.RoundPoints {
+			values = append(values, points)
+		}
+		rows = append(rows, values)
+	}
+	if err := r.writeTable(sheetName, row+1, headers, rows); err != nil {
+		return fmt.Errorf("adding leaderboard: %w", err)
+	}
+	widths := []float64{8, 20, 8, 10, 11, 8, 8, 8, 13, 10}
+	for range results.Rounds {
+		widths = append(widths, 14)
+	}
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addBracketEntriesSheet breaks down every entry pick by pick
+func (r *ExcelReporter) addBracketEntriesSheet(results *pool.BracketReport) error {
+	sheetName := "Entries"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Participant", "Round", "Winner", "Games", "Series", "Result", "Length Right", "Points", "Possible"}
+	var rows [][]interface{}
+	for _, entry := range results.Entries {
+		for _, pick := range entry.Picks {
+			games := interface{}("")
+			if pick.Games > 0 {
+				games = pick.Games
+			}
+			lengthRight := ""
+			if pick.GamesCorrect {
+				lengthRight = "Yes"
+			}
+			rows = append(rows, []interface{}{
+				entry.Participant,
+				pick.RoundName,
+				pick.Winner,
+				games,
+				pick.Series,
+				pick.Result,
+				lengthRight,
+				pick.Points,
+				pick.Possible,
+			})
+		}
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding entries: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 20, 22, 8, 7, 20, 9, 13, 8, 9); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addBracketSeriesSheet lists the series played so far
+func (r *ExcelReporter) addBracketSeriesSheet(results *pool.BracketReport) error {
+	sheetName := "Series"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Round", "Best Of", "Home", "Away", "Series", "Winner"}
+	var rows [][]interface{}
+	for _, series := range results.Series {
+		rows = append(rows, []interface{}{
+			series.Name,
+			series.BestOf,
+			series.Home,
+			series.Away,
+			series.Summary(),
+			series.Winner,
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding series: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 22, 9, 7, 7, 20, 8); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
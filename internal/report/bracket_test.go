@@ -0,0 +1,43 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/pool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateBracketReport(t *testing.T) {
+	series := []pool.Series{
+		{Round: 1, Name: "First Round", BestOf: 3, Home: "LVA", Away: "SEA", HomeWins: 2, Winner: "LVA", Loser: "SEA"},
+		{Round: 1, Name: "First Round", BestOf: 3, Home: "NYL", Away: "ATL", HomeWins: 1},
+	}
+	picks := []pool.BracketPick{
+		{Participant: "Ana", Round: 1, Winner: "LVA", Games: 2},
+		{Participant: "Ana", Round: 3, Winner: "NYL"},
+	}
+	results, err := pool.ScoreBracket(nba.WNBA, picks, series, pool.DefaultBracketConfig(nba.WNBA))
+	require.NoError(t, err)
+	results.Season = "2024"
+
+	filename := filepath.Join(t.TempDir(), "bracket.xlsx")
+	require.NoError(t, NewExcelReporter().GenerateBracketReport(results, filename))
+
+	// A points column for each round follows the totals
+	leaderboard := readSheet(t, filename, "Leaderboard")
+	require.Len(t, leaderboard, 8)
+	assert.Equal(t, []string{"Round Weights", "1, 2, 4"}, leaderboard[2])
+	assert.Equal(t, []string{"Champion", "First Round", "Semifinals", "Finals"}, leaderboard[6][9:])
+	assert.Equal(t, []string{"1", "Ana", "2", "4", "6"}, leaderboard[7][:5])
+	assert.Equal(t, []string{"NYL", "2", "0", "0"}, leaderboard[7][9:])
+
+	entries := readSheet(t, filename, "Entries")
+	require.Len(t, entries, 3)
+	assert.Equal(t, []string{"Ana", "First Round", "LVA", "2", "LVA beat SEA 2-0", pool.Correct, "Yes", "2", "0"}, entries[1])
+	assert.Equal(t, []string{"Ana", "Finals", "NYL", "", "", pool.Pending, "", "0", "4"}, entries[2], "no series length picked")
+
+	assert.Equal(t, "NYL leads ATL 1-0", readSheet(t, filename, "Series")[2][4])
+}
@@ -0,0 +1,94 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/ratings"
+)
+
+// GenerateCalibrationReport generates an Excel report of how well win
+// predictions matched results, with every forecast on a second sheet
+func (r *ExcelReporter) GenerateCalibrationReport(calibration *ratings.CalibrationReport, outcomes []ratings.PredictionOutcome, period, filename string) error {
+	sheetName := "Calibration"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Prediction Calibration (%s)", period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+	row, err := r.writeKeyValues(sheetName, 3, [][2]interface{}{
+		{"Games", calibration.Games},
+		{"Brier Score", round(calibration.BrierScore, 4)},
+		{"Baseline Brier Score", round(calibration.BaselineBrier, 4)},
+		{"Log Loss", round(calibration.LogLoss, 4)},
+		{"Accuracy", round(calibration.Accuracy, 3)},
+		{"Margin MAE", round(calibration.MarginMAE, 1)},
+	})
+	if err != nil {
+		return fmt.Errorf("adding scores: %w", err)
+	}
+
+	headers := []string{"From", "To", "Games", "Mean Predicted", "Observed Rate"}
+	var rows [][]interface{}
+	for _, bucket := range calibration.Reliability {
+		rows = append(rows, []interface{}{
+			round(bucket.Lower, 2),
+			round(bucket.Upper, 2),
+			bucket.Games,
+			round(bucket.MeanPredicted, 3),
+			round(bucket.ObservedRate, 3),
+		})
+	}
+	if err := r.writeTable(sheetName, row+1, headers, rows); err != nil {
+		return fmt.Errorf("adding reliability buckets: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 22, 10, 8, 16, 14); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	if err := r.addPredictionsSheet(outcomes); err != nil {
+		return fmt.Errorf("adding predictions: %w", err)
+	}
+
+	// Open on the scores
+	index, err := r.file.GetSheetIndex(sheetName)
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addPredictionsSheet lists every forecast beside the game's result
+func (r *ExcelReporter) addPredictionsSheet(outcomes []ratings.PredictionOutcome) error {
+	sheetName := "Predictions"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Date", "Game ID", "Away", "Home", "Home Win %", "Expected Margin", "Predicted Winner", "Actual Margin", "Correct"}
+	var rows [][]interface{}
+	for _, outcome := range outcomes {
+		correct := "No"
+		if (outcome.Prediction.HomeWinProbability >= 0.5) == outcome.HomeWon {
+			correct = "Yes"
+		}
+		rows = append(rows, []interface{}{
+			outcome.Date,
+			outcome.GameID,
+			outcome.AwayTeam,
+			outcome.HomeTeam,
+			round(outcome.Prediction.HomeWinProbability*100, 1),
+			round(outcome.Prediction.ExpectedMargin, 1),
+			outcome.Prediction.PredictedWinner,
+			outcome.ActualMargin,
+			correct,
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return err
+	}
+	return r.setColumnWidths(sheetName, 12, 10, 8, 8, 12, 16, 16, 14, 10)
+}
@@ -0,0 +1,200 @@
+package report
+
+import "github.com/jeremielumandong/nba-result/internal/nba"
+
+// gameColumn is one column of the games sheet
+type gameColumn struct {
+	header string
+	width  float64
+	value  func(r *ExcelReporter, game nba.Game) interface{}
+}
+
+// baseColumns are always present on the games sheet
+var baseColumns = []gameColumn{
+	{"Game ID", 12, func(_ *ExcelReporter, g nba.Game) interface{} { return g.GameID }},
+	{"Date", 12, func(_ *ExcelReporter, g nba.Game) interface{} { return g.Date }},
+	{"Time", 8, func(_ *ExcelReporter, g nba.Game) interface{} { return g.Time }},
+	{"Away Team", 20, func(_ *ExcelReporter, g nba.Game) interface{} { return g.AwayTeam.Name }},
+	{"Away Score", 12, func(_ *ExcelReporter, g nba.Game) interface{} { return g.AwayTeam.Score }},
+	{"Home Team", 20, func(_ *ExcelReporter, g nba.Game) interface{} { return g.HomeTeam.Name }},
+	{"Home Score", 12, func(_ *ExcelReporter, g nba.Game) interface{} { return g.HomeTeam.Score }},
+	{"Status", 12, func(_ *ExcelReporter, g nba.Game) interface{} { return g.Status }},
+	{"Quarter", 10, func(_ *ExcelReporter, g nba.Game) interface{} { return g.Quarter }},
+	{"Time Left", 12, func(_ *ExcelReporter, g nba.Game) interface{} { return g.TimeLeft }},
+	{"Winner", 20, func(r *ExcelReporter, g nba.Game) interface{} { return r.determineWinner(g) }},
+}
+
+// spoilerColumns are left out of spoiler-free reports. The quarter is a
+// spoiler too, since a fifth period gives away a close finish.
+var spoilerColumns = map[string]bool{
+	"Away Score": true,
+	"Home Score": true,
+	"Quarter":    true,
+	"Winner":     true,
+}
+
+// predictionColumns are added when any game carries a prediction
+var predictionColumns = []gameColumn{
+	{"Home Win %", 12, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Prediction == nil {
+			return nil
+		}
+		return round(g.Prediction.HomeWinProbability*100, 1)
+	}},
+	{"Expected Margin", 16, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Prediction == nil {
+			return nil
+		}
+		return round(g.Prediction.ExpectedMargin, 1)
+	}},
+	{"Predicted Winner", 16, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Prediction == nil {
+			return nil
+		}
+		return g.Prediction.PredictedWinner
+	}},
+}
+
+// watchabilityColumns are added when any game has been rated for watchability
+var watchabilityColumns = []gameColumn{
+	{"Watchability", 13, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Watchability == nil {
+			return nil
+		}
+		return g.Watchability.Score
+	}},
+}
+
+// fatigueColumns are added when any game has rest and travel worked out
+var fatigueColumns = []gameColumn{
+	{"Away Rest", 10, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, false, restDays) }},
+	{"Away Fatigue", 18, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, false, fatigueFlags) }},
+	{"Away Travel (mi)", 16, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, false, travelMiles) }},
+	{"Away Time Zones", 15, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, false, timeZones) }},
+	{"Home Rest", 10, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, true, restDays) }},
+	{"Home Fatigue", 18, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, true, fatigueFlags) }},
+	{"Home Travel (mi)", 16, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, true, travelMiles) }},
+	{"Home Time Zones", 15, func(_ *ExcelReporter, g nba.Game) interface{} { return fatigueValue(g, true, timeZones) }},
+}
+
+// oddsColumns are added when any game has closing lines
+var oddsColumns = []gameColumn{
+	{"Spread", 9, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Odds == nil || g.Odds.Spread == nil {
+			return nil
+		}
+		return *g.Odds.Spread
+	}},
+	{"Total", 9, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Odds == nil || g.Odds.Total == nil {
+			return nil
+		}
+		return *g.Odds.Total
+	}},
+	{"Home ML", 10, func(_ *ExcelReporter, g nba.Game) interface{} { return moneyline(g, true) }},
+	{"Away ML", 10, func(_ *ExcelReporter, g nba.Game) interface{} { return moneyline(g, false) }},
+}
+
+// oddsResultColumns settle the lines, and are left out of spoiler-free reports
+var oddsResultColumns = []gameColumn{
+	{"ATS Cover", 11, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Odds == nil || g.Odds.Cover == "" {
+			return nil
+		}
+		return g.Odds.Cover
+	}},
+	{"ATS Margin", 11, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Odds == nil || g.Odds.ATSMargin == nil {
+			return nil
+		}
+		return *g.Odds.ATSMargin
+	}},
+	{"O/U", 8, func(_ *ExcelReporter, g nba.Game) interface{} {
+		if g.Odds == nil || g.Odds.OverUnder == "" {
+			return nil
+		}
+		return g.Odds.OverUnder
+	}},
+}
+
+// moneyline returns one team's moneyline, or nil when there is none
+func moneyline(g nba.Game, home bool) interface{} {
+	if g.Odds == nil {
+		return nil
+	}
+	value := g.Odds.AwayMoneyline
+	if home {
+		value = g.Odds.HomeMoneyline
+	}
+	if value == 0 {
+		return nil
+	}
+	return value
+}
+
+// fatigueValue returns a value from one team's fatigue, or nil when the game has none
+func fatigueValue(g nba.Game, home bool, value func(nba.TeamFatigue) interface{}) interface{} {
+	if g.Fatigue == nil {
+		return nil
+	}
+	if home {
+		return value(g.Fatigue.Home)
+	}
+	return value(g.Fatigue.Away)
+}
+
+func restDays(f nba.TeamFatigue) interface{} {
+	if f.RestDays < 0 {
+		return nil
+	}
+	return f.RestDays
+}
+
+func fatigueFlags(f nba.TeamFatigue) interface{} { return f.Flags() }
+
+func travelMiles(f nba.TeamFatigue) interface{} { return round(f.TravelMiles, 0) }
+
+func timeZones(f nba.TeamFatigue) interface{} { return f.TimeZones }
+
+// gameColumns returns the columns of the games sheet, including optional
+// columns only when some game has data for them
+func (r *ExcelReporter) gameColumns(games []nba.Game) []gameColumn {
+	var columns []gameColumn
+	for _, column := range baseColumns {
+		if !r.spoilerFree || !spoilerColumns[column.header] {
+			columns = append(columns, column)
+		}
+	}
+
+	for _, game := range games {
+		if game.Prediction != nil {
+			columns = append(columns, predictionColumns...)
+			break
+		}
+	}
+	for _, game := range games {
+		if game.Watchability != nil {
+			columns = append(columns, watchabilityColumns...)
+			break
+		}
+	}
+
+	for _, game := range games {
+		if game.Fatigue != nil {
+			columns = append(columns, fatigueColumns...)
+			break
+		}
+	}
+
+	for _, game := range games {
+		if game.Odds != nil {
+			columns = append(columns, oddsColumns...)
+			if !r.spoilerFree {
+				columns = append(columns, oddsResultColumns...)
+			}
+			break
+		}
+	}
+
+	return columns
+}
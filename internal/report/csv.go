@@ -0,0 +1,25 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+)
+
+// writeCSV writes a header row and data rows to a CSV file
+func writeCSV(filename string, headers []string, rows [][]string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("creating file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("writing headers: %w", err)
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("writing rows: %w", err)
+	}
+	return nil
+}
@@ -10,7 +10,8 @@ import (
 
 // ExcelReporter handles Excel report generation
 type ExcelReporter struct {
-	file *excelize.File
+	file        *excelize.File
+	spoilerFree bool
 }
 
 // NewExcelReporter creates a new Excel reporter
@@ -20,6 +21,11 @@ func NewExcelReporter() *ExcelReporter {
 	}
 }
 
+// SetSpoilerFree hides scores and winners from the games sheet
+func (r *ExcelReporter) SetSpoilerFree(spoilerFree bool) {
+	r.spoilerFree = spoilerFree
+}
+
 // GenerateReport generates an Excel report from NBA games data
 func (r *ExcelReporter) GenerateReport(games []nba.Game, filename string) error {
 	sheetName := "NBA Games"
@@ -31,53 +37,41 @@ func (r *ExcelReporter) GenerateReport(games []nba.Game, filename string) error
 	// Set the sheet as active
 	r.file.SetActiveSheet(index)
 
-	// Create headers
-	headers := []string{
-		"Game ID", "Date", "Time", "Away Team", "Away Score",
-		"Home Team", "Home Score", "Status", "Quarter", "Time Left", "Winner",
-	}
+	columns := r.gameColumns(games)
 
 	// Set headers
-	for i, header := range headers {
-		cell := fmt.Sprintf("%s1", string(rune('A'+i)))
-		if err := r.file.SetCellValue(sheetName, cell, header); err != nil {
-			return fmt.Errorf("setting header %s: %w", header, err)
+	for i, column := range columns {
+		cell, err := excelize.CoordinatesToCellName(i+1, 1)
+		if err != nil {
+			return err
+		}
+		if err := r.file.SetCellValue(sheetName, cell, column.header); err != nil {
+			return fmt.Errorf("setting header %s: %w", column.header, err)
 		}
 	}
 
 	// Style headers
-	if err := r.styleHeaders(sheetName, len(headers)); err != nil {
+	if err := r.styleHeaders(sheetName, len(columns)); err != nil {
 		return fmt.Errorf("styling headers: %w", err)
 	}
 
 	// Add data
 	for i, game := range games {
 		row := i + 2 // Start from row 2 (after headers)
-		
-		data := []interface{}{
-			game.GameID,
-			game.Date,
-			game.Time,
-			game.AwayTeam.Name,
-			game.AwayTeam.Score,
-			game.HomeTeam.Name,
-			game.HomeTeam.Score,
-			game.Status,
-			game.Quarter,
-			game.TimeLeft,
-			r.determineWinner(game),
-		}
 
-		for j, value := range data {
-			cell := fmt.Sprintf("%s%d", string(rune('A'+j)), row)
-			if err := r.file.SetCellValue(sheetName, cell, value); err != nil {
+		for j, column := range columns {
+			cell, err := excelize.CoordinatesToCellName(j+1, row)
+			if err != nil {
+				return err
+			}
+			if err := r.file.SetCellValue(sheetName, cell, column.value(r, game)); err != nil {
 				return fmt.Errorf("setting cell %s: %w", cell, err)
 			}
 		}
 	}
 
 	// Auto-adjust column widths
-	if err := r.autoAdjustColumns(sheetName, len(headers)); err != nil {
+	if err := r.autoAdjustColumns(sheetName, columns); err != nil {
 		return fmt.Errorf("auto-adjusting columns: %w", err)
 	}
 
@@ -86,6 +80,26 @@ func (r *ExcelReporter) GenerateReport(games []nba.Game, filename string) error
 		return fmt.Errorf("adding summary: %w", err)
 	}
 
+	// Feats give away how the game went, so they are left out without spoilers
+	var feats []nba.Feat
+	for _, game := range games {
+		feats = append(feats, game.Feats...)
+	}
+	if len(feats) > 0 && !r.spoilerFree {
+		if err := r.addNotablePerformancesSheet(feats); err != nil {
+			return fmt.Errorf("adding notable performances: %w", err)
+		}
+		r.file.SetActiveSheet(index)
+	}
+
+	// Lead changes and comebacks give away how a game went too
+	if anyFlow(games) && !r.spoilerFree {
+		if err := r.addGameFlowSheet(games); err != nil {
+			return fmt.Errorf("adding game flow: %w", err)
+		}
+		r.file.SetActiveSheet(index)
+	}
+
 	// Delete default sheet
 	if err := r.file.DeleteSheet("Sheet1"); err != nil {
 		return fmt.Errorf("deleting default sheet: %w", err)
@@ -118,31 +132,22 @@ func (r *ExcelReporter) styleHeaders(sheetName string, numCols int) error {
 
 	// Apply style to header range
 	headerRange := fmt.Sprintf("A1:%s1", string(rune('A'+numCols-1)))
-	return r.file.SetCellStyle(sheetName, "A1", string(rune('A'+numCols-1))+"1", style)
+	lastCell, err := excelize.CoordinatesToCellName(numCols, 1)
+	if err != nil {
+		return err
+	}
+	return r.file.SetCellStyle(sheetName, "A1", lastCell, style)
 }
 
-// autoAdjustColumns adjusts column widths automatically
-func (r *ExcelReporter) autoAdjustColumns(sheetName string, numCols int) error {
-	colWidths := map[string]float64{
-		"A": 12, // Game ID
-		"B": 12, // Date
-		"C": 8,  // Time
-		"D": 20, // Away Team
-		"E": 12, // Away Score
-		"F": 20, // Home Team
-		"G": 12, // Home Score
-		"H": 12, // Status
-		"I": 10, // Quarter
-		"J": 12, // Time Left
-		"K": 20, // Winner
-	}
-
-	for i := 0; i < numCols; i++ {
-		col := string(rune('A' + i))
-		if width, ok := colWidths[col]; ok {
-			if err := r.file.SetColWidth(sheetName, col, col, width); err != nil {
-				return err
-			}
+// autoAdjustColumns sets each column to its configured width
+func (r *ExcelReporter) autoAdjustColumns(sheetName string, columns []gameColumn) error {
+	for i, column := range columns {
+		col, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return err
+		}
+		if err := r.file.SetColWidth(sheetName, col, col, column.width); err != nil {
+			return err
 		}
 	}
 	return nil
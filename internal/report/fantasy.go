@@ -0,0 +1,207 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/fantasy"
+)
+
+// GenerateFantasyReport generates an Excel report with the fantasy rankings
+// over the period and the best fantasy games of each day
+func (r *ExcelReporter) GenerateFantasyReport(rankings *fantasy.Report, filename string) error {
+	if err := r.addFantasyRankingsSheet(rankings); err != nil {
+		return err
+	}
+	if err := r.addFantasyDailySheet(rankings); err != nil {
+		return err
+	}
+
+	// Open on the rankings
+	index, err := r.file.GetSheetIndex("Rankings")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// fantasyHeaders returns the value columns of a profile: fantasy points, or
+// each category's z-score and their total
+func fantasyHeaders(profile fantasy.Profile) []string {
+	if profile.IsPoints() {
+		return []string{"FP", "FP/G"}
+	}
+	var headers []string
+	for _, key := range profile.Categories {
+		headers = append(headers, fantasy.CategoryName(key))
+	}
+	return append(headers, "Total Z")
+}
+
+// fantasyValues returns a ranking's value columns, matching fantasyHeaders
+func fantasyValues(profile fantasy.Profile, ranking fantasy.Ranking) []interface{} {
+	if profile.IsPoints() {
+		return []interface{}{ranking.Value, ranking.PerGame}
+	}
+	var values []interface{}
+	for _, key := range profile.Categories {
+		values = append(values, ranking.Categories[key])
+	}
+	return append(values, ranking.Value)
+}
+
+// addFantasyRankingsSheet ranks players over the period
+func (r *ExcelReporter) addFantasyRankingsSheet(rankings *fantasy.Report) error {
+	sheetName := "Rankings"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	title := fmt.Sprintf("Fantasy Rankings (%s scoring, %s)", rankings.Profile.Name, rankings.Period)
+	if err := r.setTitle(sheetName, "A1", title); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := append([]string{"Rank", "Player", "Team", "GP"}, fantasyHeaders(rankings.Profile)...)
+	var rows [][]interface{}
+	for _, ranking := range rankings.Rankings {
+		row := []interface{}{ranking.Rank, ranking.Name, ranking.Team, ranking.Games}
+		rows = append(rows, append(row, fantasyValues(rankings.Profile, ranking)...))
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding rankings: %w", err)
+	}
+
+	widths := []float64{8, 26, 6, 6}
+	for range headers[4:] {
+		widths = append(widths, 9)
+	}
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addFantasyDailySheet lists the best fantasy games of each day
+func (r *ExcelReporter) addFantasyDailySheet(rankings *fantasy.Report) error {
+	sheetName := "Daily"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := append([]string{"Date", "Rank", "Player", "Team", "Line"}, fantasyHeaders(rankings.Profile)...)
+	if rankings.Profile.IsPoints() {
+		headers = headers[:len(headers)-1] // Per game is the same as the total
+	}
+	var rows [][]interface{}
+	for _, day := range rankings.Daily {
+		for _, ranking := range day.Rankings {
+			row := []interface{}{day.Date, ranking.Rank, ranking.Name, ranking.Team, ranking.Line}
+			row = append(row, fantasyValues(rankings.Profile, ranking)...)
+			rows = append(rows, row[:len(headers)])
+		}
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding daily rankings: %w", err)
+	}
+
+	widths := []float64{12, 6, 26, 6, 36}
+	for range headers[5:] {
+		widths = append(widths, 9)
+	}
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// GenerateMatchupReport generates an Excel report with each fantasy
+// matchup's results so far and projected finish
+func (r *ExcelReporter) GenerateMatchupReport(week *fantasy.WeekReport, filename string) error {
+	sheetName := "Matchups"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	title := fmt.Sprintf("Fantasy Matchups, %s to %s (%s scoring)", week.Start, week.End, week.Profile.Name)
+	if err := r.setTitle(sheetName, "A1", title); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	row := 3
+	for i, matchup := range week.Matchups {
+		var names []string
+		for _, team := range matchup.Teams {
+			names = append(names, team.Name)
+		}
+		heading := fmt.Sprintf("Matchup %d: %s", i+1, strings.Join(names, " vs "))
+		if err := r.setTitle(sheetName, fmt.Sprintf("A%d", row), heading); err != nil {
+			return fmt.Errorf("adding matchup %d: %w", i+1, err)
+		}
+		row++
+
+		headers, rows := matchupTable(week.Profile, matchup)
+		if err := r.writeTable(sheetName, row, headers, rows); err != nil {
+			return fmt.Errorf("adding matchup %d: %w", i+1, err)
+		}
+		row += len(rows) + 1
+
+		if len(matchup.Teams) == 2 {
+			next, err := r.writeKeyValues(sheetName, row, [][2]interface{}{
+				{"Leader", orLevel(matchup.Leader)},
+				{"Projected Winner", orLevel(matchup.ProjectedWinner)},
+			})
+			if err != nil {
+				return fmt.Errorf("adding matchup %d: %w", i+1, err)
+			}
+			row = next
+		}
+		row++
+	}
+
+	if err := r.setColumnWidths(sheetName, 26, 14, 14, 14, 14); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return r.save(filename)
+}
+
+// matchupTable lays out a matchup: each player's points and the team totals
+// in points leagues, or each category's totals in categories leagues
+func matchupTable(profile fantasy.Profile, matchup fantasy.Matchup) ([]string, [][]interface{}) {
+	var rows [][]interface{}
+	if profile.IsPoints() {
+		headers := []string{"Player", "Team", "Played", "Left", "FP", "Projected FP"}
+		for _, team := range matchup.Teams {
+			for _, player := range team.Players {
+				rows = append(rows, []interface{}{player.Name, player.Team, player.Played, player.Remaining, player.Points, player.ProjectedPoints})
+			}
+			rows = append(rows, []interface{}{team.Name + " total", "", "", "", team.Points, team.ProjectedPoints})
+		}
+		return headers, rows
+	}
+
+	headers := []string{"Category"}
+	for _, team := range matchup.Teams {
+		headers = append(headers, team.Name, team.Name+" (proj)")
+	}
+	for i, key := range profile.Categories {
+		row := []interface{}{fantasy.CategoryName(key)}
+		for _, team := range matchup.Teams {
+			row = append(row, team.Categories[i].Actual, team.Categories[i].Projected)
+		}
+		rows = append(rows, row)
+	}
+	wins := []interface{}{"Categories won"}
+	for _, team := range matchup.Teams {
+		wins = append(wins, team.CategoryWins, team.ProjectedWins)
+	}
+	return headers, append(rows, wins)
+}
+
+// orLevel returns name, or "Level" when no team is ahead
+func orLevel(name string) string {
+	if name == "" {
+		return "Level"
+	}
+	return name
+}
@@ -0,0 +1,125 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+// GenerateFatigueReport generates an Excel report of records under each
+// fatigue condition, league-wide and per team, with every game's rest and travel
+func (r *ExcelReporter) GenerateFatigueReport(fatigue *stats.FatigueReport, games []nba.Game, filename string) error {
+	if err := r.addFatigueSheet(fatigue); err != nil {
+		return err
+	}
+	if err := r.addTeamFatigueSheet(fatigue); err != nil {
+		return err
+	}
+	if err := r.addGameFatigueSheet(games); err != nil {
+		return err
+	}
+
+	// Open on the league summary
+	index, err := r.file.GetSheetIndex("Fatigue")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addFatigueSheet lists the league-wide record under each condition
+func (r *ExcelReporter) addFatigueSheet(fatigue *stats.FatigueReport) error {
+	sheetName := "Fatigue"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Performance by Fatigue (%s)", fatigue.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{"Condition", "Games", "W", "L", "Win %", "Avg Margin"}
+	var rows [][]interface{}
+	for _, split := range fatigue.League {
+		rows = append(rows, []interface{}{
+			split.Condition,
+			split.Games,
+			split.Wins,
+			split.Losses,
+			round(split.WinPct, 3),
+			round(split.AvgMargin, 1),
+		})
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding conditions: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 22, 8, 6, 6, 8, 11); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addTeamFatigueSheet lists every team's record under each condition
+func (r *ExcelReporter) addTeamFatigueSheet(fatigue *stats.FatigueReport) error {
+	sheetName := "Team Fatigue"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Team", "GP", "Travel (mi)"}
+	widths := []float64{8, 6, 12}
+	for _, split := range fatigue.League {
+		headers = append(headers, split.Condition)
+		widths = append(widths, float64(len(split.Condition)+2))
+	}
+
+	var rows [][]interface{}
+	for _, team := range fatigue.Teams {
+		row := []interface{}{team.Team, team.Games, round(team.TravelMiles, 0)}
+		for _, split := range team.Splits {
+			row = append(row, fmt.Sprintf("%d-%d", split.Wins, split.Losses))
+		}
+		rows = append(rows, row)
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding teams: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addGameFatigueSheet lists both teams' rest and travel in every game
+func (r *ExcelReporter) addGameFatigueSheet(games []nba.Game) error {
+	sheetName := "Game Fatigue"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Date", "Game ID", "Away Team", "Home Team", "Winner"}
+	widths := []float64{12, 10, 8, 8, 8}
+	for _, column := range fatigueColumns {
+		headers = append(headers, column.header)
+		widths = append(widths, column.width)
+	}
+
+	var rows [][]interface{}
+	for _, game := range games {
+		winner, _ := game.Winner()
+		row := []interface{}{game.Date, game.GameID, game.AwayTeam.Code, game.HomeTeam.Code, winner.Code}
+		for _, column := range fatigueColumns {
+			row = append(row, column.value(r, game))
+		}
+		rows = append(rows, row)
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding games: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
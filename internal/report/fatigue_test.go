@@ -0,0 +1,52 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/stats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateFatigueReport(t *testing.T) {
+	fatigue := &stats.FatigueReport{
+		Period: "January",
+		League: []stats.FatigueSplit{
+			{Condition: "Back-to-back", Games: 4, Wins: 1, Losses: 3, WinPct: 0.25, AvgMargin: -4.5},
+			{Condition: "Rested", Games: 2, Wins: 2, WinPct: 1, AvgMargin: 8},
+		},
+		Teams: []stats.TeamFatigueSplits{
+			{Team: "BOS", Games: 3, TravelMiles: 1234.4, Splits: []stats.FatigueSplit{{Wins: 1}, {Wins: 1, Losses: 1}}},
+		},
+	}
+	games := []nba.Game{{
+		GameID:   "001",
+		Date:     "2024-01-15",
+		Status:   "Final",
+		HomeTeam: nba.Team{Code: "LAL", Score: 110},
+		AwayTeam: nba.Team{Code: "BOS", Score: 100},
+		Fatigue: &nba.Fatigue{
+			Home: nba.TeamFatigue{RestDays: -1},
+			Away: nba.TeamFatigue{RestDays: 0, BackToBack: true, TravelMiles: 2600.4, TimeZones: 3},
+		},
+	}}
+
+	filename := filepath.Join(t.TempDir(), "fatigue.xlsx")
+	require.NoError(t, NewExcelReporter().GenerateFatigueReport(fatigue, games, filename))
+
+	rows := readSheet(t, filename, "Fatigue")
+	assert.Equal(t, []string{"Back-to-back", "4", "1", "3", "0.25", "-4.5"}, rows[3])
+
+	// Each condition gets a column of team records
+	rows = readSheet(t, filename, "Team Fatigue")
+	assert.Equal(t, []string{"Team", "GP", "Travel (mi)", "Back-to-back", "Rested"}, rows[0])
+	assert.Equal(t, []string{"BOS", "3", "1234", "1-0", "1-1"}, rows[1])
+
+	rows = readSheet(t, filename, "Game Fatigue")
+	require.Len(t, rows, 2)
+	away := rows[1][5:9]
+	assert.Equal(t, []string{"0", "B2B", "2600", "3"}, away)
+	assert.Equal(t, "", rows[1][9], "no rest before a team's first game")
+}
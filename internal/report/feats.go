@@ -0,0 +1,104 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+)
+
+// GenerateFeatsReport generates an Excel report of every notable performance
+// and each player's feat counts
+func (r *ExcelReporter) GenerateFeatsReport(feats *players.FeatsReport, filename string) error {
+	if err := r.addFeatCountsSheet(feats); err != nil {
+		return err
+	}
+	if err := r.addNotablePerformancesSheet(feats.Feats); err != nil {
+		return err
+	}
+
+	// Open on the counts
+	index, err := r.file.GetSheetIndex("Feat Counts")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addFeatCountsSheet writes the league-wide count of each feat and a table
+// of each player's feats by tag
+func (r *ExcelReporter) addFeatCountsSheet(feats *players.FeatsReport) error {
+	sheetName := "Feat Counts"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Feats (%s)", feats.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	var summary [][2]interface{}
+	for _, count := range feats.Counts {
+		summary = append(summary, [2]interface{}{count.Label, count.Count})
+	}
+	row, err := r.writeKeyValues(sheetName, 3, summary)
+	if err != nil {
+		return fmt.Errorf("adding counts: %w", err)
+	}
+
+	headers := []string{"Player", "Team", "Total"}
+	for _, count := range feats.Counts {
+		headers = append(headers, count.Label)
+	}
+	var rows [][]interface{}
+	for _, player := range feats.Players {
+		values := []interface{}{player.Name, player.Team, player.Total}
+		for _, count := range feats.Counts {
+			values = append(values, player.Counts[count.Tag])
+		}
+		rows = append(rows, values)
+	}
+	if err := r.writeTable(sheetName, row+1, headers, rows); err != nil {
+		return fmt.Errorf("adding players: %w", err)
+	}
+
+	widths := []float64{26, 6, 7}
+	for range feats.Counts {
+		widths = append(widths, 14)
+	}
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addNotablePerformancesSheet lists feats one per row, in date order
+func (r *ExcelReporter) addNotablePerformancesSheet(feats []nba.Feat) error {
+	sheetName := "Notable Performances"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Date", "Player", "Team", "Opponent", "Feat", "Line", "Season Count", "Tag"}
+	var rows [][]interface{}
+	for _, feat := range feats {
+		rows = append(rows, []interface{}{
+			feat.Date,
+			feat.Name,
+			feat.Team,
+			feat.Opponent,
+			feat.Label,
+			feat.Line,
+			feat.SeasonCount,
+			feat.Tag,
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding feats: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 12, 26, 6, 10, 24, 36, 13, 18); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// GenerateComebacksReport generates an Excel report of the biggest comebacks,
+// with the flow of every game analyzed
+func (r *ExcelReporter) GenerateComebacksReport(comebacks, games []nba.Game, period, filename string) error {
+	sheetName := "Comebacks"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Biggest Comebacks (%s)", period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{"Rank", "Date", "Team", "Opponent", "Deficit", "Down Most", "Final", "Lead Changes", "Times Tied"}
+	var rows [][]interface{}
+	for i, game := range comebacks {
+		comeback := game.Flow.Comeback
+		team, opponent := game.TeamAndOpponent(comeback.Team)
+		rows = append(rows, []interface{}{
+			i + 1,
+			game.Date,
+			team.Code,
+			opponent.Code,
+			comeback.Deficit,
+			nba.PeriodClock(comeback.Period, comeback.Clock),
+			fmt.Sprintf("%d-%d", team.Score, opponent.Score),
+			game.Flow.LeadChanges,
+			game.Flow.TimesTied,
+		})
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding comebacks: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 6, 12, 7, 10, 9, 11, 9, 13, 11); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	if err := r.addGameFlowSheet(games); err != nil {
+		return err
+	}
+	index, err := r.file.GetSheetIndex(sheetName)
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addGameFlowSheet lists the lead changes, ties, largest leads, longest runs,
+// time leading and comeback of every game with a flow
+func (r *ExcelReporter) addGameFlowSheet(games []nba.Game) error {
+	sheetName := "Game Flow"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{
+		"Date", "Away", "Home", "Score", "Lead Changes", "Times Tied",
+		"Away Largest Lead", "Home Largest Lead", "Away Longest Run", "Home Longest Run",
+		"Away Time Leading", "Home Time Leading", "Time Tied", "Comeback",
+	}
+	var rows [][]interface{}
+	for _, game := range games {
+		flow := game.Flow
+		if flow == nil {
+			continue
+		}
+		comeback := ""
+		if flow.Comeback != nil {
+			comeback = fmt.Sprintf("%s from %d down", flow.Comeback.Team, flow.Comeback.Deficit)
+		}
+		rows = append(rows, []interface{}{
+			game.Date,
+			game.AwayTeam.Code,
+			game.HomeTeam.Code,
+			fmt.Sprintf("%d-%d", game.AwayTeam.Score, game.HomeTeam.Score),
+			flow.LeadChanges,
+			flow.TimesTied,
+			flow.AwayLargestLead,
+			flow.HomeLargestLead,
+			scoringRun(flow.AwayLongestRun),
+			scoringRun(flow.HomeLongestRun),
+			nba.FormatClock(flow.AwaySecondsLeading),
+			nba.FormatClock(flow.HomeSecondsLeading),
+			nba.FormatClock(flow.SecondsTied),
+			comeback,
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding game flow: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 12, 7, 7, 9, 13, 11, 17, 17, 18, 18, 17, 17, 10, 18); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// anyFlow reports whether any of the games has a flow
+func anyFlow(games []nba.Game) bool {
+	for _, game := range games {
+		if game.Flow != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// scoringRun describes a run, e.g. "12-0 (Q3 4:32)"
+func scoringRun(run nba.ScoringRun) string {
+	if run.Points == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d-0 (%s)", run.Points, nba.PeriodClock(run.Period, run.Clock))
+}
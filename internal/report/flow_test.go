@@ -0,0 +1,76 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// flowGame is a final game LAL won from 14 points down
+func flowGame() nba.Game {
+	return nba.Game{
+		GameID:   "001",
+		Date:     "2024-01-15",
+		Status:   "Final",
+		HomeTeam: nba.Team{Code: "LAL", Name: "Los Angeles Lakers", Score: 110},
+		AwayTeam: nba.Team{Code: "BOS", Name: "Boston Celtics", Score: 100},
+		Flow: &nba.Flow{
+			LeadChanges:        7,
+			TimesTied:          3,
+			HomeLargestLead:    12,
+			AwayLargestLead:    14,
+			HomeSecondsLeading: 1865,
+			AwaySecondsLeading: 900,
+			SecondsTied:        115,
+			HomeLongestRun:     nba.ScoringRun{Team: "LAL", Points: 12, Period: 3, Clock: "4:32"},
+			Comeback:           &nba.Comeback{Team: "LAL", Deficit: 14, Period: 2, Clock: "3:10"},
+		},
+	}
+}
+
+func TestGenerateComebacksReport(t *testing.T) {
+	games := []nba.Game{flowGame(), {GameID: "002", Date: "2024-01-15", Status: "Final"}}
+
+	filename := filepath.Join(t.TempDir(), "comebacks.xlsx")
+	require.NoError(t, NewExcelReporter().GenerateComebacksReport(games[:1], games, "January", filename))
+
+	rows := readSheet(t, filename, "Comebacks")
+	require.Len(t, rows, 4)
+	assert.Equal(t, []string{"1", "2024-01-15", "LAL", "BOS", "14", "Q2 3:10", "110-100", "7", "3"}, rows[3])
+
+	rows = readSheet(t, filename, "Game Flow")
+	require.Len(t, rows, 2, "games without a flow are left out")
+	assert.Equal(t, []string{"", "12-0 (Q3 4:32)", "15:00", "31:05", "1:55", "LAL from 14 down"}, rows[1][8:])
+}
+
+func TestGenerateReportGameFlow(t *testing.T) {
+	tests := []struct {
+		name        string
+		spoilerFree bool
+		want        bool // Whether the Game Flow sheet is added
+	}{
+		{"with results", false, true},
+		{"spoiler-free", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter := NewExcelReporter()
+			reporter.SetSpoilerFree(tt.spoilerFree)
+			filename := filepath.Join(t.TempDir(), "games.xlsx")
+			require.NoError(t, reporter.GenerateReport([]nba.Game{flowGame()}, filename))
+
+			file, err := excelize.OpenFile(filename)
+			require.NoError(t, err)
+			defer file.Close()
+			if tt.want {
+				assert.Contains(t, file.GetSheetList(), "Game Flow")
+			} else {
+				assert.NotContains(t, file.GetSheetList(), "Game Flow", "lead changes give the game away")
+			}
+		})
+	}
+}
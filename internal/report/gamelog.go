@@ -0,0 +1,103 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/xuri/excelize/v2"
+)
+
+// GenerateGameLogReport generates an Excel report of a team's game log with a
+// colour-coded form guide
+func (r *ExcelReporter) GenerateGameLogReport(gameLog *nba.TeamGameLog, filename string) error {
+	sheetName := "Game Log"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("%s Game Log (%s)", gameLog.Team, gameLog.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	row, err := r.writeKeyValues(sheetName, 3, [][2]interface{}{
+		{"Record", gameLog.Record},
+		{"Streak", gameLog.Streak},
+		{"Form", ""},
+	})
+	if err != nil {
+		return fmt.Errorf("adding summary: %w", err)
+	}
+
+	// One coloured cell per game, oldest first, next to the Form label
+	formRow := row - 1
+	for i, entry := range gameLog.Games {
+		cell, _ := excelize.CoordinatesToCellName(i+2, formRow)
+		if err := r.setResultCell(sheetName, cell, entry.Result); err != nil {
+			return fmt.Errorf("adding form: %w", err)
+		}
+	}
+
+	headers := []string{"Date", "Opponent", "Home/Away", "Result", "Score", "Margin", "Record", "Streak"}
+	var rows [][]interface{}
+	for _, entry := range gameLog.Games {
+		venue := "Away"
+		if entry.Home {
+			venue = "Home"
+		}
+		rows = append(rows, []interface{}{
+			entry.Date,
+			entry.Matchup(),
+			venue,
+			entry.Result,
+			fmt.Sprintf("%d-%d", entry.TeamScore, entry.OpponentScore),
+			entry.Margin,
+			entry.Record,
+			entry.Streak,
+		})
+	}
+
+	tableRow := row + 1
+	if err := r.writeTable(sheetName, tableRow, headers, rows); err != nil {
+		return fmt.Errorf("adding games: %w", err)
+	}
+	for i, entry := range gameLog.Games {
+		cell := fmt.Sprintf("D%d", tableRow+i+1)
+		if err := r.setResultCell(sheetName, cell, entry.Result); err != nil {
+			return fmt.Errorf("colouring results: %w", err)
+		}
+	}
+
+	if err := r.setColumnWidths(sheetName, 12, 10, 10, 8, 10, 8, 8, 8); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
+
+// setResultCell writes a W or L into a cell, filled green for wins and red for losses
+func (r *ExcelReporter) setResultCell(sheetName, cell, result string) error {
+	if err := r.file.SetCellValue(sheetName, cell, result); err != nil {
+		return err
+	}
+
+	fill, font := "#FFC7CE", "#9C0006"
+	if result == "W" {
+		fill, font = "#C6EFCE", "#006100"
+	}
+	style, err := r.file.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold:  true,
+			Color: font,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{fill},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{Horizontal: "center"},
+	})
+	if err != nil {
+		return err
+	}
+	return r.file.SetCellStyle(sheetName, cell, cell, style)
+}
@@ -0,0 +1,57 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// GenerateHeadToHeadReport generates an Excel report of the meetings between two teams
+func (r *ExcelReporter) GenerateHeadToHeadReport(h2h *nba.HeadToHead, filename string) error {
+	sheetName := "Head to Head"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("%s vs %s (%s)", h2h.TeamA, h2h.TeamB, h2h.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	split := h2h.HomeAway
+	row, err := r.writeKeyValues(sheetName, 3, [][2]interface{}{
+		{"Series", h2h.Record.Summary},
+		{fmt.Sprintf("%s Wins", h2h.TeamA), h2h.Record.TeamAWins},
+		{fmt.Sprintf("%s Wins", h2h.TeamB), h2h.Record.TeamBWins},
+		{fmt.Sprintf("Average Margin (%s)", h2h.TeamA), fmt.Sprintf("%+.1f", h2h.AverageMargin)},
+		{fmt.Sprintf("%s at Home", h2h.TeamA), fmt.Sprintf("%d-%d", split.TeamAHomeWins, split.TeamAHomeLosses)},
+		{fmt.Sprintf("%s on Road", h2h.TeamA), fmt.Sprintf("%d-%d", split.TeamAAwayWins, split.TeamAAwayLosses)},
+		{"Last Meeting", h2h.LastMeetingSummary},
+	})
+	if err != nil {
+		return fmt.Errorf("adding summary: %w", err)
+	}
+
+	headers := []string{"Game ID", "Date", "Away Team", "Away Score", "Home Team", "Home Score", "Winner", "Margin"}
+	var rows [][]interface{}
+	for _, meeting := range h2h.Meetings {
+		rows = append(rows, []interface{}{
+			meeting.GameID,
+			meeting.Date,
+			meeting.AwayTeam.Name,
+			meeting.AwayTeam.Score,
+			meeting.HomeTeam.Name,
+			meeting.HomeTeam.Score,
+			meeting.Winner,
+			meeting.Margin,
+		})
+	}
+	if err := r.writeTable(sheetName, row+1, headers, rows); err != nil {
+		return fmt.Errorf("adding meetings: %w", err)
+	}
+
+	if err := r.setColumnWidths(sheetName, 22, 30, 22, 12, 22, 12, 10, 10); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
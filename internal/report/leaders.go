@@ -0,0 +1,166 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/players"
+)
+
+// GenerateLeadersReport generates an Excel report with the leaderboards,
+// every player's totals and averages, and each day's top performers
+func (r *ExcelReporter) GenerateLeadersReport(leaders *players.LeadersReport, filename string) error {
+	if err := r.addLeadersSheet(leaders); err != nil {
+		return err
+	}
+	if err := r.addPlayersSheet(leaders.Players); err != nil {
+		return err
+	}
+	if err := r.addTopPerformersSheet(leaders.TopPerformers); err != nil {
+		return err
+	}
+
+	// Open on the leaderboards
+	index, err := r.file.GetSheetIndex("Leaders")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addLeadersSheet writes the leaderboards one under another
+func (r *ExcelReporter) addLeadersSheet(leaders *players.LeadersReport) error {
+	sheetName := "Leaders"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	title := fmt.Sprintf("League Leaders (%s, %d+ games to qualify)", leaders.Period, leaders.MinGames)
+	if err := r.setTitle(sheetName, "A1", title); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	row := 3
+	for _, board := range leaders.Leaderboards {
+		label := "Per Game"
+		switch {
+		case board.Percentage:
+			label = "Pct"
+		case board.Totals:
+			label = "Total"
+		}
+		if err := r.setTitle(sheetName, fmt.Sprintf("A%d", row), board.Category); err != nil {
+			return fmt.Errorf("adding %s: %w", board.Key, err)
+		}
+
+		var rows [][]interface{}
+		for _, leader := range board.Leaders {
+			value := round(leader.Value, 1)
+			if board.Percentage {
+				value = round(leader.Value, 3)
+			}
+			rows = append(rows, []interface{}{leader.Rank, leader.Name, leader.Team, leader.Games, value})
+		}
+		if err := r.writeTable(sheetName, row+1, []string{"Rank", "Player", "Team", "GP", label}, rows); err != nil {
+			return fmt.Errorf("adding %s: %w", board.Key, err)
+		}
+		row += len(rows) + 3
+	}
+
+	if err := r.setColumnWidths(sheetName, 8, 26, 8, 6, 10); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addPlayersSheet lists every player's totals and per-game averages
+func (r *ExcelReporter) addPlayersSheet(seasons []players.PlayerSeason) error {
+	sheetName := "Players"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{
+		"Player", "Team", "GP", "GS", "MIN", "PTS", "REB", "AST", "STL", "BLK", "TOV", "3PM",
+		"FGM", "FGA", "FG%", "3PA", "3P%", "FTM", "FTA", "FT%",
+		"MPG", "PPG", "RPG", "APG", "SPG", "BPG", "TPG", "3PM/G",
+	}
+	var rows [][]interface{}
+	for _, season := range seasons {
+		totals, perGame := season.Totals, season.PerGame
+		rows = append(rows, []interface{}{
+			season.Name,
+			season.Team,
+			season.Games,
+			season.Starts,
+			season.Minutes,
+			totals.Points,
+			totals.Rebounds(),
+			totals.Assists,
+			totals.Steals,
+			totals.Blocks,
+			totals.Turnovers,
+			totals.ThreePointersMade,
+			totals.FieldGoalsMade,
+			totals.FieldGoalsAttempted,
+			round(perGame.FieldGoalPct, 3),
+			totals.ThreePointersAttempted,
+			round(perGame.ThreePointPct, 3),
+			totals.FreeThrowsMade,
+			totals.FreeThrowsAttempted,
+			round(perGame.FreeThrowPct, 3),
+			round(perGame.Minutes, 1),
+			round(perGame.Points, 1),
+			round(perGame.Rebounds, 1),
+			round(perGame.Assists, 1),
+			round(perGame.Steals, 1),
+			round(perGame.Blocks, 1),
+			round(perGame.Turnovers, 1),
+			round(perGame.ThreePointersMade, 1),
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding players: %w", err)
+	}
+
+	widths := []float64{26, 6}
+	for range headers[2:] {
+		widths = append(widths, 7)
+	}
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addTopPerformersSheet lists the best lines of each day
+func (r *ExcelReporter) addTopPerformersSheet(performers []players.TopPerformer) error {
+	sheetName := "Top Performers"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Date", "Player", "Team", "Opponent", "Line", "Game Score"}
+	var rows [][]interface{}
+	for _, performer := range performers {
+		opponent := "@ " + performer.Opponent
+		if performer.Home {
+			opponent = "vs " + performer.Opponent
+		}
+		rows = append(rows, []interface{}{
+			performer.Date,
+			performer.Name,
+			performer.Team,
+			opponent,
+			performer.Line,
+			performer.GameScore,
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding top performers: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 12, 26, 6, 10, 36, 11); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
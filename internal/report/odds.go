@@ -0,0 +1,108 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/odds"
+)
+
+// GenerateATSReport generates an Excel report of each team's record against
+// the spread and the total, with every settled line
+func (r *ExcelReporter) GenerateATSReport(ats *odds.Report, filename string) error {
+	if err := r.addATSSheet(ats); err != nil {
+		return err
+	}
+	if err := r.addLinesSheet(ats); err != nil {
+		return err
+	}
+
+	// Open on the team records
+	index, err := r.file.GetSheetIndex("ATS Records")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addATSSheet lists the league-wide summary and each team's records
+func (r *ExcelReporter) addATSSheet(ats *odds.Report) error {
+	sheetName := "ATS Records"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Against the Spread (%s)", ats.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	summary := ats.Summary
+	row, err := r.writeKeyValues(sheetName, 3, [][2]interface{}{
+		{"Games Settled", summary.Games},
+		{"Lines Joined", fmt.Sprintf("%d of %d", ats.Matched, ats.Lines)},
+		{"Home ATS", summary.Home.String()},
+		{"Favorites ATS", summary.Favorites.String()},
+		{"Over/Under", summary.Totals.String()},
+	})
+	if err != nil {
+		return fmt.Errorf("adding summary: %w", err)
+	}
+
+	headers := []string{"Team", "Name", "GP", "ATS", "ATS %", "Home", "Road", "Favorite", "Underdog", "Avg Cover", "O-U-P"}
+	var rows [][]interface{}
+	for _, team := range ats.Teams {
+		rows = append(rows, []interface{}{
+			team.Team,
+			team.Name,
+			team.Games,
+			team.ATS.String(),
+			team.Pct,
+			team.Home.String(),
+			team.Road.String(),
+			team.Favorite.String(),
+			team.Underdog.String(),
+			team.CoverMargin,
+			team.Totals.String(),
+		})
+	}
+	if err := r.writeTable(sheetName, row+1, headers, rows); err != nil {
+		return fmt.Errorf("adding team records: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 16, 24, 6, 10, 8, 10, 10, 10, 10, 10, 10); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addLinesSheet lists every settled game with its lines and results
+func (r *ExcelReporter) addLinesSheet(ats *odds.Report) error {
+	sheetName := "Lines"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Date", "Away", "Home", "Score", "Spread", "Total", "Home ML", "Away ML", "ATS Cover", "ATS Margin", "O/U"}
+	var rows [][]interface{}
+	for _, game := range ats.Games {
+		row := []interface{}{
+			game.Date,
+			game.AwayTeam.Code,
+			game.HomeTeam.Code,
+			fmt.Sprintf("%d-%d", game.AwayTeam.Score, game.HomeTeam.Score),
+		}
+		for _, column := range oddsColumns {
+			row = append(row, column.value(r, game))
+		}
+		for _, column := range oddsResultColumns {
+			row = append(row, column.value(r, game))
+		}
+		rows = append(rows, row)
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding lines: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 12, 7, 7, 9, 9, 9, 10, 10, 11, 11, 8); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jeremielumandong/nba-result/internal/players"
+)
+
+// GeneratePlayerGameLogReport generates an Excel report of a player's game
+// log with averages over the logged games
+func (r *ExcelReporter) GeneratePlayerGameLogReport(gameLog *players.PlayerGameLog, filename string) error {
+	sheetName := "Player Game Log"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	title := fmt.Sprintf("%s (%s) Game Log (%s)", gameLog.Player.Name, gameLog.Player.Team, gameLog.Period)
+	if err := r.setTitle(sheetName, "A1", title); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	averages := gameLog.Averages
+	row, err := r.writeKeyValues(sheetName, 3, [][2]interface{}{
+		{"Games", len(gameLog.Games)},
+		{"Team Record", gameLog.Record},
+		{"Plus-Minus", gameLog.PlusMinus},
+		{"Minutes", round(averages.Minutes, 1)},
+		{"Points", round(averages.Points, 1)},
+		{"Rebounds", round(averages.Rebounds, 1)},
+		{"Assists", round(averages.Assists, 1)},
+		{"Field Goal %", round(averages.FieldGoalPct, 3)},
+		{"Three-Point %", round(averages.ThreePointPct, 3)},
+		{"Free Throw %", round(averages.FreeThrowPct, 3)},
+	})
+	if err != nil {
+		return fmt.Errorf("adding averages: %w", err)
+	}
+
+	headers := []string{
+		"Date", "Opponent", "Result", "Score", "Start", "MIN", "+/-", "PTS", "REB", "AST", "STL", "BLK", "TOV",
+		"FGM", "FGA", "3PM", "3PA", "FTM", "FTA", "PF", "Game Score", "Line",
+	}
+	var rows [][]interface{}
+	for _, game := range gameLog.Games {
+		start := ""
+		if game.Starter {
+			start = "Yes"
+		}
+		stats := game.Stats
+		rows = append(rows, []interface{}{
+			game.Date,
+			game.Matchup(),
+			game.Result,
+			fmt.Sprintf("%d-%d", game.TeamScore, game.OpponentScore),
+			start,
+			game.Minutes,
+			game.PlusMinus,
+			stats.Points,
+			stats.Rebounds(),
+			stats.Assists,
+			stats.Steals,
+			stats.Blocks,
+			stats.Turnovers,
+			stats.FieldGoalsMade,
+			stats.FieldGoalsAttempted,
+			stats.ThreePointersMade,
+			stats.ThreePointersAttempted,
+			stats.FreeThrowsMade,
+			stats.FreeThrowsAttempted,
+			stats.PersonalFouls,
+			game.GameScore,
+			game.Line,
+		})
+	}
+	tableRow := row + 1
+	if err := r.writeTable(sheetName, tableRow, headers, rows); err != nil {
+		return fmt.Errorf("adding games: %w", err)
+	}
+	for i, game := range gameLog.Games {
+		cell := fmt.Sprintf("C%d", tableRow+i+1)
+		if err := r.setResultCell(sheetName, cell, game.Result); err != nil {
+			return fmt.Errorf("colouring results: %w", err)
+		}
+	}
+
+	widths := []float64{14, 10, 8, 10, 7}
+	for range headers[5:20] {
+		widths = append(widths, 6)
+	}
+	widths = append(widths, 11, 34)
+	if err := r.setColumnWidths(sheetName, widths...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
+
+// WritePlayerGameLogCSV writes a player's game log to a CSV file
+func WritePlayerGameLogCSV(gameLog *players.PlayerGameLog, filename string) error {
+	var rows [][]string
+	for _, game := range gameLog.Games {
+		stats := game.Stats
+		rows = append(rows, []string{
+			gameLog.Player.Name,
+			game.Team,
+			game.Date,
+			game.GameID,
+			game.Opponent,
+			strconv.FormatBool(game.Home),
+			game.Result,
+			strconv.Itoa(game.TeamScore),
+			strconv.Itoa(game.OpponentScore),
+			strconv.FormatBool(game.Starter),
+			strconv.Itoa(game.Minutes),
+			strconv.Itoa(game.PlusMinus),
+			strconv.Itoa(stats.Points),
+			strconv.Itoa(stats.Rebounds()),
+			strconv.Itoa(stats.Assists),
+			strconv.Itoa(stats.Steals),
+			strconv.Itoa(stats.Blocks),
+			strconv.Itoa(stats.Turnovers),
+			strconv.Itoa(stats.FieldGoalsMade),
+			strconv.Itoa(stats.FieldGoalsAttempted),
+			strconv.Itoa(stats.ThreePointersMade),
+			strconv.Itoa(stats.ThreePointersAttempted),
+			strconv.Itoa(stats.FreeThrowsMade),
+			strconv.Itoa(stats.FreeThrowsAttempted),
+			strconv.Itoa(stats.PersonalFouls),
+			strconv.FormatFloat(game.GameScore, 'f', 1, 64),
+		})
+	}
+
+	return writeCSV(filename, []string{
+		"player", "team", "date", "game_id", "opponent", "home", "result", "team_score", "opponent_score",
+		"starter", "minutes", "plus_minus", "points", "rebounds", "assists", "steals", "blocks", "turnovers",
+		"fgm", "fga", "3pm", "3pa", "ftm", "fta", "fouls", "game_score",
+	}, rows)
+}
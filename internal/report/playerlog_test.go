@@ -0,0 +1,45 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/players"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePlayerGameLogReport(t *testing.T) {
+	gameLog := &players.PlayerGameLog{
+		Player: nba.Player{ID: "BOS-01", Name: "Jayson Tatum", Team: "BOS"},
+		Period: "January",
+		Games: []players.PlayerGame{{
+			Date: "2024-01-15", GameID: "001", Team: "BOS", Opponent: "LAL", Result: "W",
+			TeamScore: 112, OpponentScore: 104, Starter: true, Minutes: 36, PlusMinus: 9,
+			Line: "28 PTS, 11 REB, 9 AST", GameScore: 24.3,
+			Stats: nba.TeamStats{Points: 28, FieldGoalsMade: 10, FieldGoalsAttempted: 20, ThreePointersMade: 3,
+				ThreePointersAttempted: 8, FreeThrowsMade: 5, FreeThrowsAttempted: 6, OffensiveRebounds: 2,
+				DefensiveRebounds: 9, Assists: 9, Steals: 1, Blocks: 1, Turnovers: 3, PersonalFouls: 2},
+		}},
+		Record:    "1-0",
+		PlusMinus: 9,
+		Averages:  players.Averages{Minutes: 36, Points: 28, Rebounds: 11, Assists: 9, FieldGoalPct: 0.5},
+	}
+
+	filename := filepath.Join(t.TempDir(), "gamelog.xlsx")
+	require.NoError(t, NewExcelReporter().GeneratePlayerGameLogReport(gameLog, filename))
+
+	rows := readSheet(t, filename, "Player Game Log")
+	require.Len(t, rows, 15, "title, averages, then the games table")
+	assert.Equal(t, "Jayson Tatum (BOS) Game Log (January)", rows[0][0])
+	assert.Equal(t, []string{"Field Goal %", "0.5"}, rows[9])
+
+	header, game := rows[13], rows[14]
+	require.Len(t, game, len(header))
+	assert.Equal(t, "@ LAL", game[1])
+	assert.Equal(t, "112-104", game[3])
+	assert.Equal(t, "Yes", game[4])
+	assert.Equal(t, "11", game[8], "offensive plus defensive rebounds")
+	assert.Equal(t, "28 PTS, 11 REB, 9 AST", game[len(game)-1])
+}
@@ -0,0 +1,138 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/pool"
+)
+
+// standingHeaders head the columns of a pool leaderboard
+var standingHeaders = []string{"Rank", "Participant", "Points", "Correct", "Wrong", "Pending", "Pct", "Points Lost", "Weeks Won"}
+
+// GeneratePoolReport generates an Excel report of a pick'em pool's season and
+// weekly leaderboards, with every graded pick
+func (r *ExcelReporter) GeneratePoolReport(results *pool.Report, filename string) error {
+	if err := r.addPoolSeasonSheet(results); err != nil {
+		return err
+	}
+	if err := r.addPoolWeeklySheet(results); err != nil {
+		return err
+	}
+	if err := r.addPoolPicksSheet(results); err != nil {
+		return err
+	}
+
+	// Open on the season leaderboard
+	index, err := r.file.GetSheetIndex("Season")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addPoolSeasonSheet lists the season leaderboard and the tiebreakers
+func (r *ExcelReporter) addPoolSeasonSheet(results *pool.Report) error {
+	sheetName := "Season"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Pick'em Pool (%s)", results.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	var rows [][]interface{}
+	for _, standing := range results.Season {
+		rows = append(rows, standingValues(standing))
+	}
+	if err := r.writeTable(sheetName, 3, standingHeaders, rows); err != nil {
+		return fmt.Errorf("adding season leaderboard: %w", err)
+	}
+	note := "Ties on points are broken by: " + strings.Join(results.Tiebreakers, "; ")
+	if err := r.file.SetCellValue(sheetName, fmt.Sprintf("A%d", len(rows)+5), note); err != nil {
+		return fmt.Errorf("adding tiebreakers: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 8, 20, 8, 8, 8, 8, 8, 11, 11); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addPoolWeeklySheet lists each week's leaderboard, latest week first
+func (r *ExcelReporter) addPoolWeeklySheet(results *pool.Report) error {
+	sheetName := "Weekly"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := append([]string{"Week", "Status"}, standingHeaders[:len(standingHeaders)-1]...)
+	var rows [][]interface{}
+	for i := len(results.Weeks) - 1; i >= 0; i-- {
+		week := results.Weeks[i]
+		status := "In progress"
+		if week.Complete {
+			status = "Complete"
+		}
+		for _, standing := range week.Standings {
+			values := standingValues(standing)
+			rows = append(rows, append([]interface{}{week.Start + " to " + week.End, status}, values[:len(values)-1]...))
+		}
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding weekly leaderboards: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 26, 12, 8, 20, 8, 8, 8, 8, 8, 11); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addPoolPicksSheet lists every graded pick
+func (r *ExcelReporter) addPoolPicksSheet(results *pool.Report) error {
+	sheetName := "Picks"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Date", "Game", "Participant", "Pick", "Confidence", "Winner", "Result", "Points"}
+	var rows [][]interface{}
+	for _, pick := range results.Picks {
+		confidence := interface{}("")
+		if pick.Confidence > 0 {
+			confidence = pick.Confidence
+		}
+		rows = append(rows, []interface{}{
+			pick.Date,
+			pick.Matchup,
+			pick.Participant,
+			pick.Pick.Pick,
+			confidence,
+			pick.Winner,
+			pick.Result,
+			pick.Points,
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding picks: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 12, 12, 20, 7, 11, 8, 9, 8); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+func standingValues(standing pool.Standing) []interface{} {
+	return []interface{}{
+		standing.Rank,
+		standing.Participant,
+		standing.Points,
+		standing.Correct,
+		standing.Wrong,
+		standing.Pending,
+		standing.Pct,
+		standing.PointsLost,
+		standing.WeeksWon,
+	}
+}
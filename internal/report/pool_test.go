@@ -0,0 +1,48 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/pool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePoolReport(t *testing.T) {
+	ana := pool.Standing{Rank: 1, Participant: "Ana", Points: 5, Correct: 2, Wrong: 1, Pct: 0.667, PointsLost: 1, WeeksWon: 1}
+	ben := pool.Standing{Rank: 1, Participant: "Ben", Points: 1, Correct: 1, Pct: 1}
+	results := &pool.Report{
+		Period:      "January",
+		Tiebreakers: pool.Tiebreakers,
+		Season:      []pool.Standing{ana, ben},
+		Weeks: []pool.Week{
+			{Start: "2024-01-08", End: "2024-01-14", Complete: true, Winners: []string{"Ana"}, Standings: []pool.Standing{ana}},
+			{Start: "2024-01-15", End: "2024-01-21", Standings: []pool.Standing{ben}},
+		},
+		Picks: []pool.GradedPick{{
+			Pick:    pool.Pick{Participant: "Ana", Date: "2024-01-08", Pick: "LAL", Confidence: 3},
+			Matchup: "BOS @ LAL",
+			Winner:  "LAL",
+			Result:  pool.Correct,
+			Points:  3,
+		}},
+	}
+
+	filename := filepath.Join(t.TempDir(), "pool.xlsx")
+	require.NoError(t, NewExcelReporter().GeneratePoolReport(results, filename))
+
+	season := readSheet(t, filename, "Season")
+	assert.Equal(t, []string{"1", "Ana", "5", "2", "1", "0", "0.667", "1", "1"}, season[3])
+	assert.Contains(t, season[6][0], "Ties on points are broken by: ")
+
+	// Latest week first, without the season-only Weeks Won column
+	weekly := readSheet(t, filename, "Weekly")
+	require.Len(t, weekly, 3)
+	assert.Len(t, weekly[0], len(standingHeaders)+1)
+	assert.Equal(t, []string{"2024-01-15 to 2024-01-21", "In progress", "1", "Ben"}, weekly[1][:4])
+	assert.Equal(t, []string{"2024-01-08 to 2024-01-14", "Complete", "1", "Ana"}, weekly[2][:4])
+
+	picks := readSheet(t, filename, "Picks")
+	assert.Equal(t, []string{"2024-01-08", "BOS @ LAL", "Ana", "LAL", "3", "LAL", pool.Correct, "3"}, picks[1])
+}
@@ -0,0 +1,59 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/preview"
+)
+
+// GeneratePreviewsReport generates an Excel report with a preview card per scheduled game
+func (r *ExcelReporter) GeneratePreviewsReport(previews []preview.Preview, period, filename string) error {
+	sheetName := "Previews"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Game Previews (%s)", period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{
+		"Date", "Time", "Away Team", "Home Team",
+		"Away Record", "Home Record", "Away Record on Road", "Home Record at Home",
+		"Away Last 10", "Home Last 10", "Away Streak", "Home Streak",
+		"Away Rest", "Home Rest", "Season Series", "Predicted Winner", "Home Win %", "Expected Margin",
+	}
+	var rows [][]interface{}
+	for _, p := range previews {
+		row := []interface{}{
+			p.Date,
+			p.Time,
+			p.Away.Name,
+			p.Home.Name,
+			p.Away.Record,
+			p.Home.Record,
+			p.Away.Venue,
+			p.Home.Venue,
+			fmt.Sprintf("%s %s", p.Away.LastTen, p.Away.Form),
+			fmt.Sprintf("%s %s", p.Home.LastTen, p.Home.Form),
+			p.Away.Streak,
+			p.Home.Streak,
+			p.Away.Rest(),
+			p.Home.Rest(),
+			p.Series,
+			p.PredictedWinner,
+		}
+		if p.Prediction != nil {
+			row = append(row, round(p.Prediction.HomeWinProbability*100, 1), round(p.Prediction.ExpectedMargin, 1))
+		}
+		rows = append(rows, row)
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding previews: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 12, 8, 22, 22, 12, 12, 19, 19, 18, 18, 12, 12, 13, 13, 18, 22, 11, 15); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
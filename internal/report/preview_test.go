@@ -0,0 +1,57 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/jeremielumandong/nba-result/internal/preview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// readSheet opens a saved workbook and returns the rows of one of its sheets
+func readSheet(t *testing.T, filename, sheet string) [][]string {
+	t.Helper()
+	file, err := excelize.OpenFile(filename)
+	require.NoError(t, err)
+	defer file.Close()
+	rows, err := file.GetRows(sheet)
+	require.NoError(t, err)
+	return rows
+}
+
+func TestGeneratePreviewsReport(t *testing.T) {
+	previews := []preview.Preview{{
+		GameID: "001",
+		Date:   "2024-01-15",
+		Time:   "19:30",
+		Home: preview.TeamPreview{Code: "LAL", Name: "Los Angeles Lakers", Record: "25-17", Venue: "15-6",
+			LastTen: "6-4", Form: "WWLWLWWLWL", Streak: "L1", RestDays: 1},
+		Away: preview.TeamPreview{Code: "BOS", Name: "Boston Celtics", Record: "32-9", Venue: "14-6",
+			LastTen: "8-2", Form: "WWWWLWWWLW", Streak: "W1", RestDays: 0, BackToBack: true},
+		Series:          "BOS leads 1-0",
+		Prediction:      &nba.Prediction{HomeWinProbability: 0.4242, ExpectedMargin: -2.04},
+		PredictedWinner: "BOS",
+	}}
+
+	filename := filepath.Join(t.TempDir(), "previews.xlsx")
+	require.NoError(t, NewExcelReporter().GeneratePreviewsReport(previews, "2024-01-15", filename))
+
+	rows := readSheet(t, filename, "Previews")
+	require.Len(t, rows, 4)
+	assert.Equal(t, []string{"Game Previews (2024-01-15)"}, rows[0])
+	assert.Equal(t, []string{
+		"Date", "Time", "Away Team", "Home Team",
+		"Away Record", "Home Record", "Away Record on Road", "Home Record at Home",
+		"Away Last 10", "Home Last 10", "Away Streak", "Home Streak",
+		"Away Rest", "Home Rest", "Season Series", "Predicted Winner", "Home Win %", "Expected Margin",
+	}, rows[2])
+	assert.Equal(t, []string{
+		"2024-01-15", "19:30", "Boston Celtics", "Los Angeles Lakers",
+		"32-9", "25-17", "14-6", "15-6",
+		"8-2 WWWWLWWWLW", "6-4 WWLWLWWLWL", "W1", "L1",
+		"back-to-back", "1 day", "BOS leads 1-0", "BOS", "42.4", "-2",
+	}, rows[3])
+}
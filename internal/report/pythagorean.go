@@ -0,0 +1,87 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/stats"
+	"github.com/xuri/excelize/v2"
+)
+
+// GeneratePythagoreanReport generates an Excel report comparing each team's
+// record with its Pythagorean expectation, highlighting over- and under-performers
+func (r *ExcelReporter) GeneratePythagoreanReport(pythag *stats.PythagoreanReport, filename string) error {
+	sheetName := "Pythagorean"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	title := fmt.Sprintf("Pythagorean Expectation (%s, exponent %.2f, close games within %d)",
+		pythag.Period, pythag.Config.Exponent, pythag.Config.CloseGameMargin)
+	if err := r.setTitle(sheetName, "A1", title); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{
+		"Team", "GP", "W", "L", "Win %", "PF", "PA", "Margin", "Exp Win %", "Exp W",
+		"Luck", "Verdict", "Close W", "Close L", "Close Win %", "Other W", "Other L",
+	}
+	var rows [][]interface{}
+	for _, team := range pythag.Teams {
+		rows = append(rows, []interface{}{
+			team.Team,
+			team.Games,
+			team.Wins,
+			team.Losses,
+			round(team.WinPct, 3),
+			team.PointsFor,
+			team.PointsAgainst,
+			round(team.MarginPerGame, 1),
+			round(team.ExpectedWinPct, 3),
+			round(team.ExpectedWins, 1),
+			round(team.Luck, 1),
+			team.Verdict,
+			team.CloseWins,
+			team.CloseLosses,
+			round(team.CloseWinPct, 3),
+			team.OtherWins,
+			team.OtherLosses,
+		})
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding teams: %w", err)
+	}
+
+	for i, team := range pythag.Teams {
+		if err := r.setVerdictCell(sheetName, fmt.Sprintf("L%d", i+4), team.Verdict); err != nil {
+			return fmt.Errorf("styling verdicts: %w", err)
+		}
+	}
+
+	if err := r.setColumnWidths(sheetName, 8, 6, 6, 6, 8, 8, 8, 8, 10, 8, 8, 16, 9, 9, 12, 9, 9); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
+
+// setVerdictCell colours over-performers green and under-performers red
+func (r *ExcelReporter) setVerdictCell(sheetName, cell, verdict string) error {
+	var fill, font string
+	switch verdict {
+	case stats.VerdictOverperformer:
+		fill, font = "#C6EFCE", "#006100"
+	case stats.VerdictUnderperformer:
+		fill, font = "#FFC7CE", "#9C0006"
+	default:
+		return nil
+	}
+
+	style, err := r.file.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Color: font},
+		Fill: excelize.Fill{Type: "pattern", Color: []string{fill}, Pattern: 1},
+	})
+	if err != nil {
+		return err
+	}
+	return r.file.SetCellStyle(sheetName, cell, cell, style)
+}
@@ -0,0 +1,175 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/jeremielumandong/nba-result/internal/ratings"
+	"github.com/xuri/excelize/v2"
+)
+
+// chartedTeams is the number of top-ranked teams drawn on the rating history chart
+const chartedTeams = 10
+
+// GenerateRatingsReport generates an Excel report with power rankings and a
+// chart of each team's rating history
+func (r *ExcelReporter) GenerateRatingsReport(rankings []ratings.PowerRanking, history map[string][]ratings.RatingPoint, filename string) error {
+	sheetName := "Power Rankings"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{"Rank", "Team", "Name", "Rating", "Wins", "Losses", "Win %"}
+	var rows [][]interface{}
+	for _, ranking := range rankings {
+		rows = append(rows, []interface{}{
+			ranking.Rank,
+			ranking.Team,
+			ranking.Name,
+			round(ranking.Rating, 1),
+			ranking.Wins,
+			ranking.Losses,
+			round(ranking.WinPct, 3),
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding rankings: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 8, 8, 26, 10, 8, 8, 8); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	if err := r.addRatingHistorySheet(rankings, history); err != nil {
+		return fmt.Errorf("adding rating history: %w", err)
+	}
+
+	// Open on the rankings
+	index, err := r.file.GetSheetIndex(sheetName)
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addRatingHistorySheet writes a date-by-team table of ratings, carrying each
+// rating forward between games, and charts the top-ranked teams
+func (r *ExcelReporter) addRatingHistorySheet(rankings []ratings.PowerRanking, history map[string][]ratings.RatingPoint) error {
+	sheetName := "Rating History"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	var teams []string
+	for _, ranking := range rankings {
+		if len(history[ranking.Team]) > 0 {
+			teams = append(teams, ranking.Team)
+		}
+	}
+
+	dateSet := make(map[string]bool)
+	for _, team := range teams {
+		for _, point := range history[team] {
+			dateSet[point.Date] = true
+		}
+	}
+	dates := make([]string, 0, len(dateSet))
+	for date := range dateSet {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	headers := append([]string{"Date"}, teams...)
+	rows := make([][]interface{}, len(dates))
+	for i, date := range dates {
+		rows[i] = make([]interface{}, len(headers))
+		rows[i][0] = date
+	}
+	for col, team := range teams {
+		points := history[team]
+		next, current := 0, interface{}(nil)
+		for i, date := range dates {
+			for next < len(points) && points[next].Date <= date {
+				current = round(points[next].Rating, 1)
+				next++
+			}
+			rows[i][col+1] = current
+		}
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return err
+	}
+
+	if len(dates) == 0 {
+		return nil
+	}
+
+	var series []excelize.ChartSeries
+	for col := range teams {
+		if col == chartedTeams {
+			break
+		}
+		name, _ := excelize.ColumnNumberToName(col + 2)
+		series = append(series, excelize.ChartSeries{
+			Name:       fmt.Sprintf("'%s'!$%s$1", sheetName, name),
+			Categories: fmt.Sprintf("'%s'!$A$2:$A$%d", sheetName, len(dates)+1),
+			Values:     fmt.Sprintf("'%s'!$%s$2:$%s$%d", sheetName, name, name, len(dates)+1),
+		})
+	}
+
+	chartCol, _ := excelize.ColumnNumberToName(len(headers) + 2)
+	return r.file.AddChart(sheetName, chartCol+"2", &excelize.Chart{
+		Type:      excelize.Line,
+		Series:    series,
+		Title:     []excelize.RichTextRun{{Text: fmt.Sprintf("Elo Rating History (Top %d)", len(series))}},
+		Dimension: excelize.ChartDimension{Width: 960, Height: 480},
+		Legend:    excelize.ChartLegend{Position: "right"},
+	})
+}
+
+// WriteRatingHistoryCSV writes every team's rating history to a CSV file
+func WriteRatingHistoryCSV(history map[string][]ratings.RatingPoint, filename string) error {
+	teams := make([]string, 0, len(history))
+	for team := range history {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+
+	var rows [][]string
+	for _, team := range teams {
+		for _, point := range history[team] {
+			rows = append(rows, []string{
+				team,
+				point.Date,
+				point.Season,
+				point.GameID,
+				point.Opponent,
+				point.Result,
+				strconv.FormatFloat(point.Rating, 'f', 1, 64),
+				strconv.FormatFloat(point.Change, 'f', 1, 64),
+			})
+		}
+	}
+
+	return writeCSV(filename, []string{"team", "date", "season", "game_id", "opponent", "result", "rating", "change"}, rows)
+}
+
+// WriteRankingsCSV writes the power rankings to a CSV file
+func WriteRankingsCSV(rankings []ratings.PowerRanking, filename string) error {
+	var rows [][]string
+	for _, ranking := range rankings {
+		rows = append(rows, []string{
+			strconv.Itoa(ranking.Rank),
+			ranking.Team,
+			ranking.Name,
+			strconv.FormatFloat(ranking.Rating, 'f', 1, 64),
+			strconv.Itoa(ranking.Wins),
+			strconv.Itoa(ranking.Losses),
+			strconv.FormatFloat(ranking.WinPct, 'f', 3, 64),
+		})
+	}
+
+	return writeCSV(filename, []string{"rank", "team", "name", "rating", "wins", "losses", "win_pct"}, rows)
+}
@@ -0,0 +1,126 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// addSheet creates a new worksheet and makes it the active one
+func (r *ExcelReporter) addSheet(sheetName string) error {
+	index, err := r.file.NewSheet(sheetName)
+	if err != nil {
+		return fmt.Errorf("creating sheet: %w", err)
+	}
+	r.file.SetActiveSheet(index)
+	return nil
+}
+
+// writeTable writes a styled header row followed by data rows, starting at startRow
+func (r *ExcelReporter) writeTable(sheetName string, startRow int, headers []string, rows [][]interface{}) error {
+	for i, header := range headers {
+		cell, err := excelize.CoordinatesToCellName(i+1, startRow)
+		if err != nil {
+			return err
+		}
+		if err := r.file.SetCellValue(sheetName, cell, header); err != nil {
+			return fmt.Errorf("setting header %s: %w", header, err)
+		}
+	}
+
+	style, err := r.file.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold:  true,
+			Color: "#FFFFFF",
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#4472C4"},
+			Pattern: 1,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	first, _ := excelize.CoordinatesToCellName(1, startRow)
+	last, _ := excelize.CoordinatesToCellName(len(headers), startRow)
+	if err := r.file.SetCellStyle(sheetName, first, last, style); err != nil {
+		return fmt.Errorf("styling headers: %w", err)
+	}
+
+	for i, row := range rows {
+		for j, value := range row {
+			cell, err := excelize.CoordinatesToCellName(j+1, startRow+i+1)
+			if err != nil {
+				return err
+			}
+			if err := r.file.SetCellValue(sheetName, cell, value); err != nil {
+				return fmt.Errorf("setting cell %s: %w", cell, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeKeyValues writes label/value pairs in columns A and B, starting at startRow.
+// It returns the row after the last pair.
+func (r *ExcelReporter) writeKeyValues(sheetName string, startRow int, pairs [][2]interface{}) (int, error) {
+	row := startRow
+	for _, pair := range pairs {
+		if err := r.file.SetCellValue(sheetName, fmt.Sprintf("A%d", row), pair[0]); err != nil {
+			return row, err
+		}
+		if err := r.file.SetCellValue(sheetName, fmt.Sprintf("B%d", row), pair[1]); err != nil {
+			return row, err
+		}
+		row++
+	}
+	return row, nil
+}
+
+// setTitle writes a bold title into a cell
+func (r *ExcelReporter) setTitle(sheetName, cell, title string) error {
+	if err := r.file.SetCellValue(sheetName, cell, title); err != nil {
+		return err
+	}
+	style, err := r.file.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 14,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return r.file.SetCellStyle(sheetName, cell, cell, style)
+}
+
+// setColumnWidths sets the width of consecutive columns starting at A
+func (r *ExcelReporter) setColumnWidths(sheetName string, widths ...float64) error {
+	for i, width := range widths {
+		col, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return err
+		}
+		if err := r.file.SetColWidth(sheetName, col, col, width); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// save removes the default sheet and writes the workbook to filename
+func (r *ExcelReporter) save(filename string) error {
+	if err := r.file.DeleteSheet("Sheet1"); err != nil {
+		return fmt.Errorf("deleting default sheet: %w", err)
+	}
+	return r.file.SaveAs(filename)
+}
+
+// round rounds a value to the given number of decimal places
+func round(value float64, places int) float64 {
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(value, 'f', places, 64), 64)
+	return rounded
+}
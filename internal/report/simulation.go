@@ -0,0 +1,100 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/sim"
+)
+
+// GenerateSimulationReport generates an Excel report of simulated playoff
+// odds, with each team's seed distribution on a second sheet
+func (r *ExcelReporter) GenerateSimulationReport(result *sim.Result, filename string) error {
+	sheetName := "Playoff Odds"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Playoff Odds (%s, %d simulations, seed %d, %s model)",
+		result.Season, result.Config.Iterations, result.Config.Seed, result.Model)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{"Team", "Name", "Group", "W", "L", "Proj W", "Proj L", "Play-In %", "Playoffs %"}
+	for _, round := range result.Rounds {
+		headers = append(headers, round+" %")
+	}
+	headers = append(headers, "Champion %")
+
+	var rows [][]interface{}
+	for _, team := range result.Teams {
+		row := []interface{}{
+			team.Team,
+			team.Name,
+			team.Group,
+			team.Wins,
+			team.Losses,
+			round(team.ProjectedWins, 1),
+			round(team.ProjectedLosses, 1),
+			round(team.PlayInProbability*100, 1),
+			round(team.PlayoffProbability*100, 1),
+		}
+		for _, p := range team.RoundProbabilities {
+			row = append(row, round(p*100, 1))
+		}
+		rows = append(rows, append(row, round(team.ChampionProbability*100, 1)))
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding odds: %w", err)
+	}
+
+	widths := []float64{8, 26, 10, 6, 6, 8, 8, 10, 11}
+	for range result.Rounds {
+		widths = append(widths, 14)
+	}
+	if err := r.setColumnWidths(sheetName, append(widths, 12)...); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	if err := r.addSeedOddsSheet(result); err != nil {
+		return fmt.Errorf("adding seed odds: %w", err)
+	}
+
+	// Open on the playoff odds
+	index, err := r.file.GetSheetIndex(sheetName)
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addSeedOddsSheet writes each team's probability of finishing in each seed
+func (r *ExcelReporter) addSeedOddsSheet(result *sim.Result) error {
+	sheetName := "Seed Odds"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	seeds := 0
+	for _, team := range result.Teams {
+		if len(team.SeedProbabilities) > seeds {
+			seeds = len(team.SeedProbabilities)
+		}
+	}
+
+	headers := []string{"Team", "Group"}
+	for seed := 1; seed <= seeds; seed++ {
+		headers = append(headers, fmt.Sprintf("%d", seed))
+	}
+
+	var rows [][]interface{}
+	for _, team := range result.Teams {
+		row := []interface{}{team.Team, team.Group}
+		for _, p := range team.SeedProbabilities {
+			row = append(row, round(p*100, 1))
+		}
+		rows = append(rows, row)
+	}
+	return r.writeTable(sheetName, 1, headers, rows)
+}
@@ -0,0 +1,113 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/standings"
+)
+
+// GenerateStandingsReport generates an Excel report of the standings, with
+// each team's strength of schedule when it has been worked out
+func (r *ExcelReporter) GenerateStandingsReport(table *standings.Standings, filename string) error {
+	sheetName := "Standings"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Standings (%s)", table.Season)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{
+		"Group", "Rank", "Team", "Name", "W", "L", "Win %", "GB", "Home", "Road", "Last 10", "Streak",
+		"SOS Played", "SOS Remaining", "Games Left", "Home Left", "Road Left", "B2B Left",
+	}
+	var rows [][]interface{}
+	for _, team := range table.Teams {
+		row := []interface{}{
+			team.Group,
+			team.Rank,
+			team.Team,
+			team.Name,
+			team.Wins,
+			team.Losses,
+			round(team.WinPct, 3),
+			team.GamesBack,
+			team.Home,
+			team.Road,
+			team.LastTen,
+			team.Streak,
+		}
+		if schedule := team.Schedule; schedule != nil {
+			row = append(row,
+				round(schedule.Played.SOS, 3),
+				round(schedule.Remaining.SOS, 3),
+				schedule.Remaining.Games,
+				schedule.RemainingHome,
+				schedule.RemainingRoad,
+				schedule.BackToBacksLeft,
+			)
+		}
+		rows = append(rows, row)
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding standings: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 10, 6, 6, 26, 5, 5, 7, 6, 7, 7, 8, 7, 11, 14, 11, 10, 10, 9); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
+
+// GenerateScheduleReport generates an Excel report of every team's strength
+// of schedule, played and remaining
+func (r *ExcelReporter) GenerateScheduleReport(schedule *standings.ScheduleReport, filename string) error {
+	sheetName := "Strength of Schedule"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Strength of Schedule (%s)", schedule.Season)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{
+		"Team", "Name", "W", "L",
+		"Played", "Opp Win %", "Opp Opp Win %", "SOS", "Opp Rating", "Rank",
+		"Remaining", "Opp Win %", "Opp Opp Win %", "SOS", "Opp Rating", "Rank",
+		"Home Left", "Road Left", "B2B Left",
+	}
+	var rows [][]interface{}
+	for _, team := range schedule.Teams {
+		rows = append(rows, []interface{}{
+			team.Team,
+			team.Name,
+			team.Wins,
+			team.Losses,
+			team.Played.Games,
+			round(team.Played.OpponentWinPct, 3),
+			round(team.Played.OpponentsOpponentWinPct, 3),
+			round(team.Played.SOS, 3),
+			round(team.Played.OpponentRating, 1),
+			team.PlayedRank,
+			team.Remaining.Games,
+			round(team.Remaining.OpponentWinPct, 3),
+			round(team.Remaining.OpponentsOpponentWinPct, 3),
+			round(team.Remaining.SOS, 3),
+			round(team.Remaining.OpponentRating, 1),
+			team.RemainingRank,
+			team.RemainingHome,
+			team.RemainingRoad,
+			team.BackToBacksLeft,
+		})
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding teams: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 6, 26, 5, 5, 8, 10, 13, 7, 11, 6, 10, 10, 13, 7, 11, 6, 10, 10, 9); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
@@ -0,0 +1,62 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+// GenerateStreaksReport generates an Excel report of team streaks and milestones
+func (r *ExcelReporter) GenerateStreaksReport(streaks *stats.StreaksReport, period, filename string) error {
+	sheetName := "Streaks"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Streaks (%s)", period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := []string{
+		"Team", "Record", "Current", "Longest W", "Longest L",
+		"Home Current", "Home Longest W", "Home Longest L",
+		"Away Current", "Away Longest W", "Away Longest L",
+	}
+	var rows [][]interface{}
+	for _, team := range streaks.Teams {
+		rows = append(rows, []interface{}{
+			team.Team,
+			fmt.Sprintf("%d-%d", team.Wins, team.Losses),
+			team.Overall.Current.String(),
+			team.Overall.LongestWin.Length,
+			team.Overall.LongestLoss.Length,
+			team.Home.Current.String(),
+			team.Home.LongestWin.Length,
+			team.Home.LongestLoss.Length,
+			team.Away.Current.String(),
+			team.Away.LongestWin.Length,
+			team.Away.LongestLoss.Length,
+		})
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding streaks: %w", err)
+	}
+
+	milestoneRow := len(rows) + 6
+	if err := r.setTitle(sheetName, fmt.Sprintf("A%d", milestoneRow), "MILESTONES"); err != nil {
+		return fmt.Errorf("adding milestones: %w", err)
+	}
+	var milestoneRows [][]interface{}
+	for _, milestone := range streaks.Milestones {
+		milestoneRows = append(milestoneRows, []interface{}{milestone.Date, milestone.Team, milestone.Description})
+	}
+	if err := r.writeTable(sheetName, milestoneRow+1, []string{"Date", "Team", "Milestone"}, milestoneRows); err != nil {
+		return fmt.Errorf("adding milestones: %w", err)
+	}
+
+	if err := r.setColumnWidths(sheetName, 12, 10, 14, 12, 12, 14, 16, 16, 14, 16, 16); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	return r.save(filename)
+}
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/stats"
+)
+
+// efficiencyHeaders are the column headers written by efficiencyRow
+var efficiencyHeaders = []string{"Poss", "Pace", "ORtg", "DRtg", "Net", "eFG%", "TOV%", "ORB%", "FT Rate", "Opp eFG%", "Opp TOV%", "DRB%", "Opp FT Rate"}
+
+// GenerateTeamStatsReport generates an Excel report of team efficiency, with
+// season aggregates on the first sheet and every game on the second
+func (r *ExcelReporter) GenerateTeamStatsReport(teamStats *stats.TeamStatsReport, filename string) error {
+	sheetName := "Team Stats"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("Team Efficiency (%s)", teamStats.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	headers := append([]string{"Team", "GP", "PTS", "Opp PTS"}, efficiencyHeaders...)
+	var rows [][]interface{}
+	for _, team := range teamStats.Season {
+		row := []interface{}{team.Team, team.Games, team.Totals.Points, team.Opponents.Points}
+		rows = append(rows, append(row, efficiencyRow(team.Efficiency)...))
+	}
+	if err := r.writeTable(sheetName, 3, headers, rows); err != nil {
+		return fmt.Errorf("adding season stats: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 8, 6, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 9, 10, 10, 8, 12); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	if err := r.addGameStatsSheet(teamStats); err != nil {
+		return fmt.Errorf("adding game stats: %w", err)
+	}
+
+	// Open on the season aggregates
+	index, err := r.file.GetSheetIndex(sheetName)
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addGameStatsSheet writes each team's efficiency in every game
+func (r *ExcelReporter) addGameStatsSheet(teamStats *stats.TeamStatsReport) error {
+	sheetName := "Game Stats"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := append([]string{"Date", "Game ID", "Team", "Opponent", "Home/Away", "PTS", "Opp PTS"}, efficiencyHeaders...)
+	var rows [][]interface{}
+	for _, game := range teamStats.Games {
+		homeAway := "Away"
+		if game.Home {
+			homeAway = "Home"
+		}
+		row := []interface{}{game.Date, game.GameID, game.Team, game.Opponent, homeAway, game.Totals.Points, game.Opponents.Points}
+		rows = append(rows, append(row, efficiencyRow(game.Efficiency)...))
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return err
+	}
+	return r.setColumnWidths(sheetName, 12, 10, 8, 10, 10, 8, 8)
+}
+
+// efficiencyRow formats efficiency metrics for a table row, with rates as percentages
+func efficiencyRow(e stats.Efficiency) []interface{} {
+	return []interface{}{
+		round(e.Possessions, 1),
+		round(e.Pace, 1),
+		round(e.OffensiveRating, 1),
+		round(e.DefensiveRating, 1),
+		round(e.NetRating, 1),
+		round(e.EffectiveFGPct*100, 1),
+		round(e.TurnoverPct*100, 1),
+		round(e.OffensiveReboundPct*100, 1),
+		round(e.FreeThrowRate, 3),
+		round(e.OppEffectiveFGPct*100, 1),
+		round(e.OppTurnoverPct*100, 1),
+		round(e.DefensiveReboundPct*100, 1),
+		round(e.OppFreeThrowRate, 3),
+	}
+}
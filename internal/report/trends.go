@@ -0,0 +1,190 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/jeremielumandong/nba-result/internal/stats"
+	"github.com/xuri/excelize/v2"
+)
+
+// GenerateTrendsReport generates an Excel report of home-court advantage,
+// scoring by quarter, overtimes and halftime leads, with weekly trend charts
+func (r *ExcelReporter) GenerateTrendsReport(trends *stats.TrendsReport, filename string) error {
+	if err := r.addTrendsSheet(trends); err != nil {
+		return err
+	}
+	if err := r.addHomeCourtSheet(trends); err != nil {
+		return err
+	}
+	if err := r.addWeeklyTrendsSheet(trends); err != nil {
+		return err
+	}
+
+	// Open on the summary
+	index, err := r.file.GetSheetIndex("Trends")
+	if err != nil {
+		return err
+	}
+	r.file.SetActiveSheet(index)
+
+	return r.save(filename)
+}
+
+// addTrendsSheet summarises the league-wide figures and charts scoring by quarter
+func (r *ExcelReporter) addTrendsSheet(trends *stats.TrendsReport) error {
+	sheetName := "Trends"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+	if err := r.setTitle(sheetName, "A1", fmt.Sprintf("League Trends (%s)", trends.Period)); err != nil {
+		return fmt.Errorf("adding title: %w", err)
+	}
+
+	next, err := r.writeKeyValues(sheetName, 3, [][2]interface{}{
+		{"Games", trends.Games},
+		{"Home Record", fmt.Sprintf("%d-%d", trends.HomeCourt.HomeWins, trends.HomeCourt.AwayWins)},
+		{"Home Win %", round(trends.HomeCourt.HomeWinPct, 3)},
+		{"Avg Home Margin", round(trends.HomeCourt.HomeMargin, 1)},
+		{"Overtime Games", trends.OvertimeGames},
+		{"Overtime %", round(trends.OvertimePct, 3)},
+		{"Halftime Leads Held", fmt.Sprintf("%d of %d", trends.Halftime.Held, trends.Halftime.Games)},
+		{"Halftime Lead Held %", round(trends.Halftime.HeldPct, 3)},
+		{"Tied at Halftime", trends.Halftime.Tied},
+	})
+	if err != nil {
+		return fmt.Errorf("adding summary: %w", err)
+	}
+
+	quarterRow := next + 1
+	headers := []string{"Period", "Games", "Home", "Away", "Total"}
+	var rows [][]interface{}
+	for _, quarter := range trends.Quarters {
+		rows = append(rows, []interface{}{quarter.Period, quarter.Games, quarter.Home, quarter.Away, quarter.Total})
+	}
+	if err := r.writeTable(sheetName, quarterRow, headers, rows); err != nil {
+		return fmt.Errorf("adding quarters: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 22, 10, 10, 10, 10); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	// Overtime periods are shorter, so only the regulation quarters are charted
+	first, last := quarterRow+1, quarterRow+len(rows)-1
+	var series []excelize.ChartSeries
+	for _, col := range []string{"C", "D"} {
+		series = append(series, excelize.ChartSeries{
+			Name:       fmt.Sprintf("'%s'!$%s$%d", sheetName, col, quarterRow),
+			Categories: fmt.Sprintf("'%s'!$A$%d:$A$%d", sheetName, first, last),
+			Values:     fmt.Sprintf("'%s'!$%s$%d:$%s$%d", sheetName, col, first, col, last),
+		})
+	}
+	return r.file.AddChart(sheetName, "G3", &excelize.Chart{
+		Type:      excelize.Col,
+		Series:    series,
+		Title:     []excelize.RichTextRun{{Text: "Average Points by Quarter"}},
+		Dimension: excelize.ChartDimension{Width: 640, Height: 360},
+		Legend:    excelize.ChartLegend{Position: "bottom"},
+	})
+}
+
+// addHomeCourtSheet lists every team's home and road results
+func (r *ExcelReporter) addHomeCourtSheet(trends *stats.TrendsReport) error {
+	sheetName := "Home Court"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{
+		"Team", "Home W", "Home L", "Home Win %", "Home Margin",
+		"Road W", "Road L", "Road Win %", "Road Margin", "Advantage",
+	}
+	var rows [][]interface{}
+	for _, team := range trends.Teams {
+		rows = append(rows, []interface{}{
+			team.Team,
+			team.HomeWins,
+			team.HomeLosses,
+			round(team.HomeWinPct, 3),
+			round(team.HomeMargin, 1),
+			team.RoadWins,
+			team.RoadLosses,
+			round(team.RoadWinPct, 3),
+			round(team.RoadMargin, 1),
+			round(team.Advantage, 1),
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding teams: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 8, 8, 8, 11, 12, 8, 8, 11, 12, 10); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+	return nil
+}
+
+// addWeeklyTrendsSheet lists the figures of each week and charts how they move
+func (r *ExcelReporter) addWeeklyTrendsSheet(trends *stats.TrendsReport) error {
+	sheetName := "Weekly Trends"
+	if err := r.addSheet(sheetName); err != nil {
+		return err
+	}
+
+	headers := []string{
+		"Week", "Start", "End", "Games", "Home Win %", "Home Margin",
+		"Points/Game", "Overtime %", "Halftime Held %",
+	}
+	var rows [][]interface{}
+	for _, week := range trends.Weekly {
+		rows = append(rows, []interface{}{
+			fmt.Sprintf("Week %d", week.Week),
+			week.StartDate,
+			week.EndDate,
+			week.Games,
+			round(week.HomeWinPct, 3),
+			round(week.HomeMargin, 1),
+			round(week.PointsPerGame, 1),
+			round(week.OvertimePct, 3),
+			round(week.HalftimeHeld, 3),
+		})
+	}
+	if err := r.writeTable(sheetName, 1, headers, rows); err != nil {
+		return fmt.Errorf("adding weeks: %w", err)
+	}
+	if err := r.setColumnWidths(sheetName, 10, 12, 12, 8, 11, 12, 12, 11, 15); err != nil {
+		return fmt.Errorf("adjusting columns: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+	last := len(rows) + 1
+	categories := fmt.Sprintf("'%s'!$A$2:$A$%d", sheetName, last)
+	charts := []struct {
+		cell, title string
+		columns     []string
+	}{
+		{"K2", "Home Win % and Halftime Leads Held by Week", []string{"E", "I"}},
+		{"K22", "Home Margin by Week", []string{"F"}},
+		{"K42", "Points per Game by Week", []string{"G"}},
+	}
+	for _, chart := range charts {
+		var series []excelize.ChartSeries
+		for _, col := range chart.columns {
+			series = append(series, excelize.ChartSeries{
+				Name:       fmt.Sprintf("'%s'!$%s$1", sheetName, col),
+				Categories: categories,
+				Values:     fmt.Sprintf("'%s'!$%s$2:$%s$%d", sheetName, col, col, last),
+			})
+		}
+		if err := r.file.AddChart(sheetName, chart.cell, &excelize.Chart{
+			Type:      excelize.Line,
+			Series:    series,
+			Title:     []excelize.RichTextRun{{Text: chart.title}},
+			Dimension: excelize.ChartDimension{Width: 640, Height: 360},
+			Legend:    excelize.ChartLegend{Position: "bottom"},
+		}); err != nil {
+			return fmt.Errorf("adding chart: %w", err)
+		}
+	}
+	return nil
+}
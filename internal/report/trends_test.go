@@ -0,0 +1,62 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/stats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestGenerateTrendsReport(t *testing.T) {
+	trends := &stats.TrendsReport{
+		Period:    "January",
+		Games:     10,
+		HomeCourt: stats.HomeCourt{Games: 10, HomeWins: 6, AwayWins: 4, HomeWinPct: 0.6, HomeMargin: 2.5},
+		Teams: []stats.TeamHomeCourt{
+			{Team: "BOS", HomeWins: 3, HomeLosses: 1, HomeWinPct: 0.75, HomeMargin: 6.5, RoadWins: 1, RoadLosses: 1, RoadWinPct: 0.5, RoadMargin: -1, Advantage: 7.5},
+		},
+		Quarters: []stats.QuarterScoring{
+			{Period: "Q1", Games: 10, Home: 28.5, Away: 27, Total: 55.5},
+			{Period: "Q2", Games: 10, Home: 27, Away: 27, Total: 54},
+			{Period: "Q3", Games: 10, Home: 29, Away: 26, Total: 55},
+			{Period: "Q4", Games: 10, Home: 26, Away: 25, Total: 51},
+			{Period: "OT", Games: 1, Home: 12, Away: 8, Total: 20},
+		},
+		OvertimeGames: 1,
+		OvertimePct:   0.1,
+		Halftime:      stats.HalftimeLeads{Games: 9, Held: 7, Blown: 2, Tied: 1, HeldPct: 0.778},
+		Weekly: []stats.WeeklyTrend{
+			{Week: 1, StartDate: "2024-01-01", EndDate: "2024-01-07", Games: 10, HomeWinPct: 0.6, PointsPerGame: 215.5},
+		},
+	}
+
+	filename := filepath.Join(t.TempDir(), "trends.xlsx")
+	require.NoError(t, NewExcelReporter().GenerateTrendsReport(trends, filename))
+
+	file, err := excelize.OpenFile(filename)
+	require.NoError(t, err)
+	defer file.Close()
+	assert.Equal(t, []string{"Trends", "Home Court", "Weekly Trends"}, file.GetSheetList())
+
+	for cell, want := range map[string]string{
+		"B4":  "6-4",    // Home record
+		"B9":  "7 of 9", // Halftime leads held
+		"A13": "Period", // The quarter table follows the summary
+		"D14": "27",     // Away points in Q1
+		"A18": "OT",
+	} {
+		value, err := file.GetCellValue("Trends", cell)
+		require.NoError(t, err)
+		assert.Equal(t, want, value, cell)
+	}
+
+	value, err := file.GetCellValue("Home Court", "J2")
+	require.NoError(t, err)
+	assert.Equal(t, "7.5", value, "advantage")
+	value, err = file.GetCellValue("Weekly Trends", "A2")
+	require.NoError(t, err)
+	assert.Equal(t, "Week 1", value)
+}
@@ -0,0 +1,78 @@
+package sim
+
+import "github.com/jeremielumandong/nba-result/internal/nba"
+
+// Format describes how a league seeds its standings and plays its postseason
+type Format struct {
+	ByConference bool     // Seed and play each conference separately until the finals
+	DirectSeeds  int      // Teams per group that qualify without a play-in
+	PlayIn       bool     // The next four teams play in for the last two seeds
+	SeriesLength []int    // Best-of length of each round
+	Rounds       []string // Name of each round
+}
+
+// FormatFor returns the postseason format of a league
+func FormatFor(league nba.League) Format {
+	switch league.Code {
+	case nba.WNBA.Code:
+		return Format{
+			DirectSeeds:  8,
+			SeriesLength: []int{3, 5, 7},
+			Rounds:       []string{"First Round", "Semifinals", "Finals"},
+		}
+	case nba.GLeague.Code:
+		return Format{
+			ByConference: true,
+			DirectSeeds:  4,
+			SeriesLength: []int{1, 1, 3},
+			Rounds:       []string{"Conference Semifinals", "Conference Finals", "Finals"},
+		}
+	default:
+		return Format{
+			ByConference: true,
+			DirectSeeds:  6,
+			PlayIn:       true,
+			SeriesLength: []int{7, 7, 7, 7},
+			Rounds:       []string{"First Round", "Conference Semifinals", "Conference Finals", "Finals"},
+		}
+	}
+}
+
+// PlayoffSeeds returns the number of teams per group in the playoff bracket
+func (f Format) PlayoffSeeds() int {
+	if f.PlayIn {
+		return f.DirectSeeds + 2
+	}
+	return f.DirectSeeds
+}
+
+// RoundSeries returns the number of series played in a round, counting from zero
+func (f Format) RoundSeries(round int) int {
+	teams := f.PlayoffSeeds()
+	if f.ByConference {
+		teams *= 2
+	}
+	return teams >> (round + 1)
+}
+
+// bracketOrder returns seeds in bracket order, so that adjacent pairs meet in
+// the first round and the top two seeds can only meet in the last round
+func bracketOrder(seeds int) []int {
+	order := []int{1}
+	for size := 2; size <= seeds; size *= 2 {
+		next := make([]int, 0, size)
+		for _, seed := range order {
+			next = append(next, seed, size+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// homeGames lists the games of a best-of series hosted by the higher seed
+var homeGames = map[int][]bool{
+	1: {true},
+	3: {true, false, true},
+	5: {true, true, false, false, true},
+	7: {true, true, false, false, true, false, true},
+}
@@ -0,0 +1,72 @@
+package sim
+
+import (
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Model gives the probability that the home team beats the away team.
+// Models are read concurrently by simulation workers and must not change
+// while a simulation runs.
+type Model interface {
+	WinProbability(home, away string) float64
+}
+
+// DefaultHomeWinRate is the share of games the home team wins between equal teams
+const DefaultHomeWinRate = 0.6
+
+// recordPriorGames is the number of .500 games added to every team's record
+// so that early-season records are not taken at face value
+const recordPriorGames = 5
+
+// RecordModel predicts games from win-loss records using the log5 method
+type RecordModel struct {
+	homeWinRate float64
+	winPct      map[string]float64
+}
+
+// NewRecordModel creates a record-based model from completed games
+func NewRecordModel(games []nba.Game, homeWinRate float64) *RecordModel {
+	wins, played := make(map[string]int), make(map[string]int)
+	for _, game := range games {
+		winner, ok := game.Winner()
+		if !ok {
+			continue
+		}
+		wins[strings.ToUpper(winner.Code)]++
+		played[strings.ToUpper(game.HomeTeam.Code)]++
+		played[strings.ToUpper(game.AwayTeam.Code)]++
+	}
+
+	winPct := make(map[string]float64, len(played))
+	for team, games := range played {
+		winPct[team] = (float64(wins[team]) + recordPriorGames/2.0) / float64(games+recordPriorGames)
+	}
+
+	return &RecordModel{homeWinRate: homeWinRate, winPct: winPct}
+}
+
+// WinProbability returns the probability that the home team beats the away team
+func (m *RecordModel) WinProbability(home, away string) float64 {
+	p := log5(m.pct(home), m.pct(away))
+	return log5(p, 1-m.homeWinRate)
+}
+
+// pct returns a team's regressed win percentage, or .500 for a team without games
+func (m *RecordModel) pct(team string) float64 {
+	if pct, ok := m.winPct[strings.ToUpper(team)]; ok {
+		return pct
+	}
+	return 0.5
+}
+
+// log5 returns the probability that a team winning a share a of its games
+// beats a team winning a share b
+func log5(a, b float64) float64 {
+	denominator := a*(1-b) + b*(1-a)
+	if denominator == 0 {
+		return 0.5
+	}
+	return a * (1 - b) / denominator
+}
@@ -0,0 +1,411 @@
+package sim
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Config holds the parameters of a simulation run
+type Config struct {
+	Iterations int   `json:"iterations"`
+	Workers    int   `json:"workers"`
+	Seed       int64 `json:"seed"`
+}
+
+// DefaultConfig returns a simulation of 10,000 seasons on four workers
+func DefaultConfig() Config {
+	return Config{Iterations: 10000, Workers: 4, Seed: 1}
+}
+
+// TeamOdds is a team's share of simulated seasons ending in each outcome
+type TeamOdds struct {
+	Team                string    `json:"team"`
+	Name                string    `json:"name"`
+	Group               string    `json:"group"` // Conference, or "League" when seeded league-wide
+	Wins                int       `json:"wins"`
+	Losses              int       `json:"losses"`
+	ProjectedWins       float64   `json:"projected_wins"`
+	ProjectedLosses     float64   `json:"projected_losses"`
+	SeedProbabilities   []float64 `json:"seed_probabilities"` // Index 0 is the 1 seed
+	PlayInProbability   float64   `json:"play_in_probability"`
+	PlayoffProbability  float64   `json:"playoff_probability"`
+	RoundProbabilities  []float64 `json:"round_probabilities"` // Probability of playing in each round
+	ChampionProbability float64   `json:"champion_probability"`
+}
+
+// Result is the outcome of a simulation run
+type Result struct {
+	Season         string     `json:"season"`
+	Model          string     `json:"model"`
+	Config         Config     `json:"config"`
+	GamesPlayed    int        `json:"games_played"`
+	GamesRemaining int        `json:"games_remaining"`
+	Rounds         []string   `json:"rounds"`
+	Teams          []TeamOdds `json:"teams"`
+}
+
+// Simulator plays out the rest of a season many times
+type Simulator struct {
+	format    Format
+	model     Model
+	config    Config
+	teams     []nba.TeamInfo
+	index     map[string]int
+	groups    [][]int // Team indexes per seeding group
+	group     []int   // Seeding group of each team
+	wins      []int
+	losses    []int
+	games     []int    // Games played plus games remaining
+	remaining [][2]int // Home and away team indexes
+	played    int
+}
+
+// NewSimulator creates a simulator for a league's season from its completed
+// and remaining games. Games involving teams outside the league are ignored.
+func NewSimulator(league nba.League, played, remaining []nba.Game, model Model, config Config) (*Simulator, error) {
+	if config.Iterations <= 0 {
+		return nil, fmt.Errorf("iterations must be positive")
+	}
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+
+	s := &Simulator{
+		format: FormatFor(league),
+		model:  model,
+		config: config,
+		teams:  nba.Teams(league),
+		index:  make(map[string]int),
+		group:  make([]int, len(nba.Teams(league))),
+	}
+
+	groupIndex := make(map[string]int)
+	for i, team := range s.teams {
+		s.index[team.Code] = i
+		group := s.groupName(team)
+		if _, ok := groupIndex[group]; !ok {
+			groupIndex[group] = len(s.groups)
+			s.groups = append(s.groups, nil)
+		}
+		s.group[i] = groupIndex[group]
+		s.groups[s.group[i]] = append(s.groups[s.group[i]], i)
+	}
+	for _, group := range s.groups {
+		if len(group) < s.format.PlayoffSeeds() {
+			return nil, fmt.Errorf("%d teams cannot fill %d playoff seeds", len(group), s.format.PlayoffSeeds())
+		}
+	}
+
+	s.wins = make([]int, len(s.teams))
+	s.losses = make([]int, len(s.teams))
+	for _, game := range played {
+		winner, ok := game.Winner()
+		home, homeOK := s.index[strings.ToUpper(game.HomeTeam.Code)]
+		away, awayOK := s.index[strings.ToUpper(game.AwayTeam.Code)]
+		if !ok || !homeOK || !awayOK {
+			continue
+		}
+		if strings.EqualFold(winner.Code, game.HomeTeam.Code) {
+			s.wins[home]++
+			s.losses[away]++
+		} else {
+			s.wins[away]++
+			s.losses[home]++
+		}
+		s.played++
+	}
+
+	for _, game := range remaining {
+		home, homeOK := s.index[strings.ToUpper(game.HomeTeam.Code)]
+		away, awayOK := s.index[strings.ToUpper(game.AwayTeam.Code)]
+		if homeOK && awayOK {
+			s.remaining = append(s.remaining, [2]int{home, away})
+		}
+	}
+
+	s.games = make([]int, len(s.teams))
+	for i := range s.teams {
+		s.games[i] = s.wins[i] + s.losses[i]
+	}
+	for _, game := range s.remaining {
+		s.games[game[0]]++
+		s.games[game[1]]++
+	}
+
+	return s, nil
+}
+
+// groupName returns the seeding group of a team
+func (s *Simulator) groupName(team nba.TeamInfo) string {
+	if s.format.ByConference {
+		return team.Conference
+	}
+	return "League"
+}
+
+// tally counts outcomes across the seasons simulated by one worker
+type tally struct {
+	wins     []int
+	seeds    [][]int
+	playIn   []int
+	playoffs []int
+	rounds   [][]int
+	champion []int
+}
+
+func (s *Simulator) newTally() *tally {
+	t := &tally{
+		wins:     make([]int, len(s.teams)),
+		seeds:    make([][]int, len(s.teams)),
+		playIn:   make([]int, len(s.teams)),
+		playoffs: make([]int, len(s.teams)),
+		rounds:   make([][]int, len(s.teams)),
+		champion: make([]int, len(s.teams)),
+	}
+	for i := range s.teams {
+		t.seeds[i] = make([]int, len(s.groups[s.group[i]]))
+		t.rounds[i] = make([]int, len(s.format.Rounds))
+	}
+	return t
+}
+
+// add merges another worker's counts into t
+func (t *tally) add(other *tally) {
+	for i := range t.wins {
+		t.wins[i] += other.wins[i]
+		t.playIn[i] += other.playIn[i]
+		t.playoffs[i] += other.playoffs[i]
+		t.champion[i] += other.champion[i]
+		for j := range t.seeds[i] {
+			t.seeds[i][j] += other.seeds[i][j]
+		}
+		for j := range t.rounds[i] {
+			t.rounds[i][j] += other.rounds[i][j]
+		}
+	}
+}
+
+// Run simulates the season. Each iteration draws from its own random source
+// seeded with the configured seed plus the iteration number, so results
+// depend only on the seed and not on the number of workers.
+func (s *Simulator) Run() *Result {
+	tallies := make([]*tally, s.config.Workers)
+	var wg sync.WaitGroup
+	for w := range tallies {
+		tallies[w] = s.newTally()
+		wg.Add(1)
+		go func(worker int, t *tally) {
+			defer wg.Done()
+			for i := worker; i < s.config.Iterations; i += s.config.Workers {
+				s.simulateSeason(rand.New(rand.NewSource(s.config.Seed+int64(i))), t)
+			}
+		}(w, tallies[w])
+	}
+	wg.Wait()
+
+	total := s.newTally()
+	for _, t := range tallies {
+		total.add(t)
+	}
+	return s.result(total)
+}
+
+// simulateSeason plays the remaining schedule and the postseason once
+func (s *Simulator) simulateSeason(rng *rand.Rand, t *tally) {
+	wins := append([]int(nil), s.wins...)
+	for _, game := range s.remaining {
+		home, away := game[0], game[1]
+		if rng.Float64() < s.probability(home, away) {
+			wins[home]++
+		} else {
+			wins[away]++
+		}
+	}
+	for i, w := range wins {
+		t.wins[i] += w
+	}
+
+	var champions []int
+	for _, group := range s.groups {
+		standings := s.rank(group, wins, rng)
+		for seed, team := range standings {
+			t.seeds[team][seed]++
+		}
+
+		bracket := s.seedBracket(standings, rng, t)
+		for _, team := range bracket {
+			t.playoffs[team]++
+		}
+		champions = append(champions, s.playRounds(bracket, 0, wins, rng, t))
+	}
+
+	// Group champions meet in the remaining rounds
+	champion := champions[0]
+	if len(champions) > 1 {
+		champion = s.playRounds(champions, s.groupRounds(), wins, rng, t)
+	}
+	t.champion[champion]++
+}
+
+// groupRounds returns the number of rounds played within a seeding group
+func (s *Simulator) groupRounds() int {
+	rounds := 0
+	for size := s.format.PlayoffSeeds(); size > 1; size /= 2 {
+		rounds++
+	}
+	return rounds
+}
+
+// rank orders a group by win percentage, breaking ties at random
+func (s *Simulator) rank(group, wins []int, rng *rand.Rand) []int {
+	standings := append([]int(nil), group...)
+	rng.Shuffle(len(standings), func(i, j int) { standings[i], standings[j] = standings[j], standings[i] })
+	sort.SliceStable(standings, func(i, j int) bool {
+		return s.winPct(wins, standings[i]) > s.winPct(wins, standings[j])
+	})
+	return standings
+}
+
+// seedBracket returns the playoff teams of a group in seed order, playing
+// the play-in tournament when the format has one
+func (s *Simulator) seedBracket(standings []int, rng *rand.Rand, t *tally) []int {
+	direct := s.format.DirectSeeds
+	bracket := append([]int(nil), standings[:direct]...)
+	if !s.format.PlayIn {
+		return bracket
+	}
+
+	for _, team := range standings[direct : direct+4] {
+		t.playIn[team]++
+	}
+	seventh, eighth, ninth, tenth := standings[direct], standings[direct+1], standings[direct+2], standings[direct+3]
+
+	// 7 hosts 8 for the 7 seed; the loser hosts the winner of 9 v 10 for the 8 seed
+	winner, loser := s.playSeries(seventh, eighth, 1, rng)
+	survivor, _ := s.playSeries(ninth, tenth, 1, rng)
+	last, _ := s.playSeries(loser, survivor, 1, rng)
+	return append(bracket, winner, last)
+}
+
+// playRounds plays seeded teams through the bracket from a round onwards and
+// returns the winner
+func (s *Simulator) playRounds(seeded []int, round int, wins []int, rng *rand.Rand, t *tally) int {
+	alive := make([]int, len(seeded))
+	if round == 0 {
+		for i, seed := range bracketOrder(len(seeded)) {
+			alive[i] = seeded[seed-1]
+		}
+	} else {
+		copy(alive, seeded)
+	}
+
+	for ; len(alive) > 1; round++ {
+		next := make([]int, 0, len(alive)/2)
+		for i := 0; i < len(alive); i += 2 {
+			a, b := alive[i], alive[i+1]
+			t.rounds[a][round]++
+			t.rounds[b][round]++
+
+			// The better record has home court; within a group that is the higher seed
+			if round > 0 && s.winPct(wins, b) > s.winPct(wins, a) {
+				a, b = b, a
+			}
+			winner, _ := s.playSeries(a, b, s.format.SeriesLength[round], rng)
+			next = append(next, winner)
+		}
+		alive = next
+	}
+	return alive[0]
+}
+
+// playSeries plays a best-of series with the higher seed at home in the
+// format's pattern and returns the winner and loser
+func (s *Simulator) playSeries(higher, lower, bestOf int, rng *rand.Rand) (int, int) {
+	needed := bestOf/2 + 1
+	higherWins, lowerWins := 0, 0
+	for game := 0; higherWins < needed && lowerWins < needed; game++ {
+		var higherWon bool
+		if homeGames[bestOf][game] {
+			higherWon = rng.Float64() < s.probability(higher, lower)
+		} else {
+			higherWon = rng.Float64() >= s.probability(lower, higher)
+		}
+		if higherWon {
+			higherWins++
+		} else {
+			lowerWins++
+		}
+	}
+	if higherWins == needed {
+		return higher, lower
+	}
+	return lower, higher
+}
+
+// probability returns the model's home win probability by team index
+func (s *Simulator) probability(home, away int) float64 {
+	return s.model.WinProbability(s.teams[home].Code, s.teams[away].Code)
+}
+
+// winPct returns a team's final win percentage in a simulated season, or
+// .500 for a team without games
+func (s *Simulator) winPct(wins []int, team int) float64 {
+	if s.games[team] == 0 {
+		return 0.5
+	}
+	return float64(wins[team]) / float64(s.games[team])
+}
+
+// result converts outcome counts into probabilities
+func (s *Simulator) result(total *tally) *Result {
+	n := float64(s.config.Iterations)
+	result := &Result{
+		Config:         s.config,
+		GamesPlayed:    s.played,
+		GamesRemaining: len(s.remaining),
+		Rounds:         s.format.Rounds,
+	}
+
+	for i, info := range s.teams {
+		projected := float64(total.wins[i]) / n
+
+		odds := TeamOdds{
+			Team:                info.Code,
+			Name:                info.Name,
+			Group:               s.groupName(info),
+			Wins:                s.wins[i],
+			Losses:              s.losses[i],
+			ProjectedWins:       projected,
+			ProjectedLosses:     float64(s.games[i]) - projected,
+			PlayInProbability:   float64(total.playIn[i]) / n,
+			PlayoffProbability:  float64(total.playoffs[i]) / n,
+			ChampionProbability: float64(total.champion[i]) / n,
+		}
+		odds.SeedProbabilities = make([]float64, len(total.seeds[i]))
+		for seed := range odds.SeedProbabilities {
+			odds.SeedProbabilities[seed] = float64(total.seeds[i][seed]) / n
+		}
+		odds.RoundProbabilities = make([]float64, len(s.format.Rounds))
+		for round := range odds.RoundProbabilities {
+			odds.RoundProbabilities[round] = float64(total.rounds[i][round]) / n
+		}
+		result.Teams = append(result.Teams, odds)
+	}
+
+	sort.SliceStable(result.Teams, func(i, j int) bool {
+		a, b := result.Teams[i], result.Teams[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.ProjectedWins != b.ProjectedWins {
+			return a.ProjectedWins > b.ProjectedWins
+		}
+		return a.Team < b.Team
+	})
+	return result
+}
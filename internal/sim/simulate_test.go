@@ -0,0 +1,116 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedModel gives the home team the same probability in every game
+type fixedModel float64
+
+func (m fixedModel) WinProbability(home, away string) float64 { return float64(m) }
+
+func game(home, away string, homeScore, awayScore int) nba.Game {
+	return nba.Game{
+		Date:     "2024-01-02",
+		HomeTeam: nba.Team{Code: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Score: awayScore},
+		Status:   "Final",
+	}
+}
+
+func TestSimulator_Deterministic(t *testing.T) {
+	played := []nba.Game{game("BOS", "LAL", 110, 100), game("DEN", "NYK", 99, 98)}
+	remaining := []nba.Game{
+		{HomeTeam: nba.Team{Code: "LAL"}, AwayTeam: nba.Team{Code: "BOS"}, Status: "Scheduled"},
+		{HomeTeam: nba.Team{Code: "NYK"}, AwayTeam: nba.Team{Code: "MIA"}, Status: "Scheduled"},
+	}
+	model := NewRecordModel(played, DefaultHomeWinRate)
+
+	run := func(workers int) *Result {
+		simulator, err := NewSimulator(nba.NBA, played, remaining, model, Config{Iterations: 500, Workers: workers, Seed: 42})
+		require.NoError(t, err)
+		return simulator.Run()
+	}
+
+	single, parallel := run(1), run(4)
+	assert.Equal(t, single.Teams, parallel.Teams)
+	assert.Equal(t, 2, single.GamesPlayed)
+	assert.Equal(t, 2, single.GamesRemaining)
+}
+
+func TestSimulator_Probabilities(t *testing.T) {
+	simulator, err := NewSimulator(nba.NBA, nil, nil, fixedModel(0.6), Config{Iterations: 200, Workers: 2, Seed: 7})
+	require.NoError(t, err)
+	result := simulator.Run()
+
+	require.Len(t, result.Teams, 30)
+	assert.Equal(t, []string{"First Round", "Conference Semifinals", "Conference Finals", "Finals"}, result.Rounds)
+
+	var champions, playoffs, playIn, topSeeds float64
+	for _, team := range result.Teams {
+		champions += team.ChampionProbability
+		playoffs += team.PlayoffProbability
+		playIn += team.PlayInProbability
+		topSeeds += team.SeedProbabilities[0]
+		assert.InDelta(t, team.PlayoffProbability, team.RoundProbabilities[0], 1e-9)
+		assert.GreaterOrEqual(t, team.RoundProbabilities[0], team.RoundProbabilities[3])
+
+		seeds := 0.0
+		for _, p := range team.SeedProbabilities {
+			seeds += p
+		}
+		assert.InDelta(t, 1, seeds, 1e-9)
+	}
+	assert.InDelta(t, 1, champions, 1e-9)
+	assert.InDelta(t, 16, playoffs, 1e-9)
+	assert.InDelta(t, 8, playIn, 1e-9)
+	assert.InDelta(t, 2, topSeeds, 1e-9)
+}
+
+func TestSimulator_LeagueFormats(t *testing.T) {
+	simulator, err := NewSimulator(nba.WNBA, nil, nil, fixedModel(0.5), Config{Iterations: 50, Seed: 1})
+	require.NoError(t, err)
+	result := simulator.Run()
+
+	playoffs := 0.0
+	for _, team := range result.Teams {
+		assert.Equal(t, "League", team.Group)
+		assert.Zero(t, team.PlayInProbability)
+		playoffs += team.PlayoffProbability
+	}
+	assert.InDelta(t, 8, playoffs, 1e-9)
+
+	_, err = NewSimulator(nba.NBA, nil, nil, fixedModel(0.5), Config{})
+	assert.Error(t, err)
+}
+
+func TestBracketOrder(t *testing.T) {
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, bracketOrder(8))
+	assert.Equal(t, []int{1, 4, 2, 3}, bracketOrder(4))
+}
+
+func TestRoundSeries(t *testing.T) {
+	nbaFormat := FormatFor(nba.NBA)
+	assert.Equal(t, []int{8, 4, 2, 1}, []int{nbaFormat.RoundSeries(0), nbaFormat.RoundSeries(1), nbaFormat.RoundSeries(2), nbaFormat.RoundSeries(3)})
+	wnbaFormat := FormatFor(nba.WNBA)
+	assert.Equal(t, []int{4, 2, 1}, []int{wnbaFormat.RoundSeries(0), wnbaFormat.RoundSeries(1), wnbaFormat.RoundSeries(2)})
+	assert.Equal(t, 4, FormatFor(nba.GLeague).RoundSeries(0))
+}
+
+func TestRecordModel(t *testing.T) {
+	model := NewRecordModel([]nba.Game{
+		game("BOS", "LAL", 110, 100),
+		game("BOS", "LAL", 110, 100),
+	}, 0.5)
+
+	assert.Greater(t, model.WinProbability("BOS", "LAL"), 0.5)
+	assert.InDelta(t, 1, model.WinProbability("BOS", "LAL")+model.WinProbability("LAL", "BOS"), 1e-9)
+	assert.InDelta(t, 0.5, model.WinProbability("MIA", "NYK"), 1e-9)
+
+	home := NewRecordModel(nil, DefaultHomeWinRate)
+	assert.InDelta(t, DefaultHomeWinRate, home.WinProbability("MIA", "NYK"), 1e-9)
+}
@@ -0,0 +1,235 @@
+package standings
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Rater rates the current strength of teams, such as an Elo engine
+type Rater interface {
+	Rating(team string) float64
+}
+
+// ScheduleStrength measures how hard a set of opponents is
+type ScheduleStrength struct {
+	Games                   int     `json:"games"`
+	OpponentWinPct          float64 `json:"opponent_win_pct"`           // Opponents' win % in games against other teams
+	OpponentsOpponentWinPct float64 `json:"opponents_opponent_win_pct"` // Average opponent win % of those opponents
+	SOS                     float64 `json:"sos"`                        // (2 × opponent win % + opponents' opponent win %) / 3
+	OpponentRating          float64 `json:"opponent_rating,omitempty"`  // Average current rating of the opponents
+}
+
+// TeamSchedule is the strength of a team's schedule so far and still to come
+type TeamSchedule struct {
+	Team            string           `json:"team"`
+	Name            string           `json:"name"`
+	Wins            int              `json:"wins"`
+	Losses          int              `json:"losses"`
+	Played          ScheduleStrength `json:"played"`
+	Remaining       ScheduleStrength `json:"remaining"`
+	PlayedRank      int              `json:"played_rank"`    // 1 is the hardest schedule played
+	RemainingRank   int              `json:"remaining_rank"` // 1 is the hardest schedule left
+	RemainingHome   int              `json:"remaining_home"`
+	RemainingRoad   int              `json:"remaining_road"`
+	BackToBacksLeft int              `json:"back_to_backs_left"`
+}
+
+// ScheduleReport holds every team's strength of schedule, hardest remaining first
+type ScheduleReport struct {
+	Season string         `json:"season"`
+	Teams  []TeamSchedule `json:"teams"`
+}
+
+// records holds every team's wins and games, overall and against each opponent
+type records struct {
+	wins, games       map[string]int
+	h2hWins, h2hGames map[string]map[string]int
+}
+
+func newRecords(played []nba.Game) *records {
+	r := &records{
+		wins:     make(map[string]int),
+		games:    make(map[string]int),
+		h2hWins:  make(map[string]map[string]int),
+		h2hGames: make(map[string]map[string]int),
+	}
+	for _, game := range played {
+		winner, ok := game.Winner()
+		if !ok {
+			continue
+		}
+		home, away := strings.ToUpper(game.HomeTeam.Code), strings.ToUpper(game.AwayTeam.Code)
+		for _, pair := range [][2]string{{home, away}, {away, home}} {
+			team, opponent := pair[0], pair[1]
+			if r.h2hGames[team] == nil {
+				r.h2hWins[team] = make(map[string]int)
+				r.h2hGames[team] = make(map[string]int)
+			}
+			r.games[team]++
+			r.h2hGames[team][opponent]++
+			if strings.EqualFold(winner.Code, team) {
+				r.wins[team]++
+				r.h2hWins[team][opponent]++
+			}
+		}
+	}
+	return r
+}
+
+// winPctExcluding returns a team's win percentage leaving out its games
+// against another team, so that a team's own results don't count towards
+// the strength of its opponents
+func (r *records) winPctExcluding(team, excluded string) (float64, bool) {
+	games := r.games[team] - r.h2hGames[team][excluded]
+	if games <= 0 {
+		return 0, false
+	}
+	return float64(r.wins[team]-r.h2hWins[team][excluded]) / float64(games), true
+}
+
+// opponentWinPct averages the win percentage of each opponent game, leaving
+// out the opponents' games against team
+func (r *records) opponentWinPct(team string, opponents []string) float64 {
+	total, counted := 0.0, 0
+	for _, opponent := range opponents {
+		if pct, ok := r.winPctExcluding(opponent, team); ok {
+			total += pct
+			counted++
+		}
+	}
+	if counted == 0 {
+		return 0
+	}
+	return total / float64(counted)
+}
+
+// BuildScheduleReport works out the strength of every team's schedule: the
+// completed games in played so far, and the games still in remaining, both
+// judged by the records of the played games. Rater, if not nil, adds the
+// average current rating of the opponents.
+func BuildScheduleReport(league nba.League, played, remaining []nba.Game, rater Rater, season string) *ScheduleReport {
+	r := newRecords(played)
+
+	playedOpponents := make(map[string][]string)
+	for _, game := range played {
+		if _, ok := game.Winner(); !ok {
+			continue
+		}
+		home, away := strings.ToUpper(game.HomeTeam.Code), strings.ToUpper(game.AwayTeam.Code)
+		playedOpponents[home] = append(playedOpponents[home], away)
+		playedOpponents[away] = append(playedOpponents[away], home)
+	}
+
+	// Each team's opponent win % so far stands in for its opponents' opponents
+	owp := make(map[string]float64)
+	for team, opponents := range playedOpponents {
+		owp[team] = r.opponentWinPct(team, opponents)
+	}
+
+	report := &ScheduleReport{Season: season}
+	for _, info := range nba.Teams(league) {
+		team := TeamSchedule{
+			Team:   info.Code,
+			Name:   info.Name,
+			Wins:   r.wins[info.Code],
+			Losses: r.games[info.Code] - r.wins[info.Code],
+		}
+
+		var remainingOpponents []string
+		for _, game := range remaining {
+			switch {
+			case strings.EqualFold(game.HomeTeam.Code, info.Code):
+				team.RemainingHome++
+				remainingOpponents = append(remainingOpponents, strings.ToUpper(game.AwayTeam.Code))
+			case strings.EqualFold(game.AwayTeam.Code, info.Code):
+				team.RemainingRoad++
+				remainingOpponents = append(remainingOpponents, strings.ToUpper(game.HomeTeam.Code))
+			}
+		}
+
+		team.Played = scheduleStrength(r, owp, rater, info.Code, playedOpponents[info.Code])
+		team.Remaining = scheduleStrength(r, owp, rater, info.Code, remainingOpponents)
+		team.BackToBacksLeft = backToBacksLeft(info.Code, played, remaining)
+		report.Teams = append(report.Teams, team)
+	}
+
+	rank(report.Teams, func(t TeamSchedule) float64 { return t.Played.SOS }, func(t *TeamSchedule, rank int) { t.PlayedRank = rank })
+	rank(report.Teams, func(t TeamSchedule) float64 { return t.Remaining.SOS }, func(t *TeamSchedule, rank int) { t.RemainingRank = rank })
+	sort.SliceStable(report.Teams, func(i, j int) bool { return report.Teams[i].RemainingRank < report.Teams[j].RemainingRank })
+	return report
+}
+
+// scheduleStrength measures a team's opponents, one entry per game
+func scheduleStrength(r *records, owp map[string]float64, rater Rater, team string, opponents []string) ScheduleStrength {
+	strength := ScheduleStrength{Games: len(opponents)}
+	if len(opponents) == 0 {
+		return strength
+	}
+
+	strength.OpponentWinPct = r.opponentWinPct(team, opponents)
+	total := 0.0
+	for _, opponent := range opponents {
+		total += owp[opponent]
+	}
+	strength.OpponentsOpponentWinPct = total / float64(len(opponents))
+	strength.SOS = (2*strength.OpponentWinPct + strength.OpponentsOpponentWinPct) / 3
+
+	if rater != nil {
+		total = 0
+		for _, opponent := range opponents {
+			total += rater.Rating(opponent)
+		}
+		strength.OpponentRating = total / float64(len(opponents))
+	}
+	return strength
+}
+
+// backToBacksLeft counts a team's remaining games on the day after another of its games
+func backToBacksLeft(team string, played, remaining []nba.Game) int {
+	dates := make(map[string]bool)
+	for _, game := range append(append([]nba.Game(nil), played...), remaining...) {
+		if game.HasTeam(team) {
+			dates[game.Date] = true
+		}
+	}
+
+	count := 0
+	for _, game := range remaining {
+		if !game.HasTeam(team) {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", game.Date)
+		if err != nil {
+			continue
+		}
+		if dates[date.AddDate(0, 0, -1).Format("2006-01-02")] {
+			count++
+		}
+	}
+	return count
+}
+
+// rank numbers teams from the highest value down, sharing ranks on ties
+func rank(teams []TeamSchedule, value func(TeamSchedule) float64, set func(*TeamSchedule, int)) {
+	order := make([]int, len(teams))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := teams[order[i]], teams[order[j]]
+		if value(a) != value(b) {
+			return value(a) > value(b)
+		}
+		return a.Team < b.Team
+	})
+	current := 0
+	for position, index := range order {
+		if position == 0 || value(teams[index]) != value(teams[order[position-1]]) {
+			current = position + 1
+		}
+		set(&teams[index], current)
+	}
+}
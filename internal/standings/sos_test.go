@@ -0,0 +1,70 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedRatings rates each team from a map
+type fixedRatings map[string]float64
+
+func (r fixedRatings) Rating(team string) float64 { return r[team] }
+
+func findSchedule(t *testing.T, report *ScheduleReport, code string) TeamSchedule {
+	t.Helper()
+	for _, team := range report.Teams {
+		if team.Team == code {
+			return team
+		}
+	}
+	require.Failf(t, "team not found", "no schedule for %s", code)
+	return TeamSchedule{}
+}
+
+func TestBuildScheduleReport(t *testing.T) {
+	remaining := []nba.Game{
+		scheduled("2024-01-05", "NYK", "BOS"),
+		scheduled("2024-01-06", "BOS", "LAL"),
+	}
+	ratings := fixedRatings{"NYK": 1600, "LAL": 1400}
+
+	report := BuildScheduleReport(nba.NBA, playedGames(), remaining, ratings, "2023-24")
+	assert.Len(t, report.Teams, len(nba.Teams(nba.NBA)))
+
+	// BOS played LAL twice (0-1 against others) and NYK once (1-0 against others)
+	bos := findSchedule(t, report, "BOS")
+	assert.Equal(t, 2, bos.Wins)
+	assert.Equal(t, 1, bos.Losses)
+	assert.Equal(t, 3, bos.Played.Games)
+	assert.InDelta(t, 1.0/3, bos.Played.OpponentWinPct, 1e-9)
+	assert.InDelta(t, 11.0/18, bos.Played.OpponentsOpponentWinPct, 1e-9)
+	assert.InDelta(t, 23.0/54, bos.Played.SOS, 1e-9)
+	assert.InDelta(t, 1400*2.0/3+1600/3.0, bos.Played.OpponentRating, 1e-9)
+	assert.Equal(t, 3, bos.PlayedRank)
+
+	assert.Equal(t, 2, bos.Remaining.Games)
+	assert.InDelta(t, 0.5, bos.Remaining.OpponentWinPct, 1e-9)
+	assert.InDelta(t, 1500, bos.Remaining.OpponentRating, 1e-9)
+	assert.Equal(t, 1, bos.RemainingHome)
+	assert.Equal(t, 1, bos.RemainingRoad)
+	assert.Equal(t, 2, bos.BackToBacksLeft, "the day after the last game played and after the next one")
+
+	lal := findSchedule(t, report, "LAL")
+	assert.InDelta(t, 31.0/54, lal.Played.SOS, 1e-9)
+	assert.Equal(t, 1, lal.PlayedRank)
+	assert.Equal(t, 1, lal.RemainingRoad)
+	assert.Equal(t, 0, lal.BackToBacksLeft)
+
+	nyk := findSchedule(t, report, "NYK")
+	assert.Equal(t, 2, nyk.PlayedRank)
+	assert.Equal(t, 0, nyk.BackToBacksLeft)
+
+	// Teams without games share the last rank
+	mia := findSchedule(t, report, "MIA")
+	assert.Equal(t, 4, mia.PlayedRank)
+	assert.Zero(t, mia.Played.Games)
+	assert.Equal(t, 1, report.Teams[0].RemainingRank, "hardest remaining first")
+}
@@ -0,0 +1,121 @@
+package standings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// lastGames is the number of recent games in a standing's form
+const lastGames = 10
+
+// Standing is a team's place in its conference
+type Standing struct {
+	Rank      int     `json:"rank"` // Within the group
+	Team      string  `json:"team"`
+	Name      string  `json:"name"`
+	Group     string  `json:"group"` // Conference, or "League" when the league has none
+	Wins      int     `json:"wins"`
+	Losses    int     `json:"losses"`
+	WinPct    float64 `json:"win_pct"`
+	GamesBack float64 `json:"games_back"` // Behind the group leader
+	Home      string  `json:"home"`
+	Road      string  `json:"road"`
+	LastTen   string  `json:"last_ten"`
+	Streak    string  `json:"streak"`
+
+	Schedule *TeamSchedule `json:"schedule,omitempty"` // Strength of schedule, when worked out
+}
+
+// Standings ranks every team in a league by win percentage within its group
+type Standings struct {
+	Season string     `json:"season"`
+	Teams  []Standing `json:"teams"`
+}
+
+// Build ranks every team in the league's registry by its record over the
+// completed games in played
+func Build(league nba.League, played []nba.Game, season string) *Standings {
+	standings := &Standings{Season: season}
+	for _, info := range nba.Teams(league) {
+		gameLog := nba.BuildTeamGameLog(info.Code, played)
+		standing := Standing{
+			Team:   info.Code,
+			Name:   info.Name,
+			Group:  info.Conference,
+			Wins:   gameLog.Wins,
+			Losses: gameLog.Losses,
+			Streak: gameLog.Streak,
+		}
+		if standing.Group == "" {
+			standing.Group = "League"
+		}
+		if games := gameLog.Wins + gameLog.Losses; games > 0 {
+			standing.WinPct = float64(gameLog.Wins) / float64(games)
+		}
+
+		var homeWins, homeLosses, roadWins, roadLosses int
+		for _, entry := range gameLog.Games {
+			switch {
+			case entry.Home && entry.Result == "W":
+				homeWins++
+			case entry.Home:
+				homeLosses++
+			case entry.Result == "W":
+				roadWins++
+			default:
+				roadLosses++
+			}
+		}
+		standing.Home = fmt.Sprintf("%d-%d", homeWins, homeLosses)
+		standing.Road = fmt.Sprintf("%d-%d", roadWins, roadLosses)
+
+		gameLog.Last(lastGames)
+		wins := strings.Count(gameLog.Form, "W")
+		standing.LastTen = fmt.Sprintf("%d-%d", wins, len(gameLog.Form)-wins)
+
+		standings.Teams = append(standings.Teams, standing)
+	}
+
+	sort.SliceStable(standings.Teams, func(i, j int) bool {
+		a, b := standings.Teams[i], standings.Teams[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.WinPct != b.WinPct {
+			return a.WinPct > b.WinPct
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		return a.Team < b.Team
+	})
+
+	var leader Standing
+	for i := range standings.Teams {
+		team := &standings.Teams[i]
+		if i == 0 || team.Group != standings.Teams[i-1].Group {
+			leader = *team
+			team.Rank = 1
+		} else {
+			team.Rank = standings.Teams[i-1].Rank + 1
+		}
+		team.GamesBack = float64((leader.Wins-team.Wins)+(team.Losses-leader.Losses)) / 2
+	}
+	return standings
+}
+
+// AddSchedules attaches each team's strength of schedule to its standing
+func (s *Standings) AddSchedules(report *ScheduleReport) {
+	schedules := make(map[string]TeamSchedule)
+	for _, team := range report.Teams {
+		schedules[team.Team] = team
+	}
+	for i := range s.Teams {
+		if schedule, ok := schedules[s.Teams[i].Team]; ok {
+			s.Teams[i].Schedule = &schedule
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func final(date, home string, homeScore int, away string, awayScore int) nba.Game {
+	return nba.Game{
+		GameID:   date + home + away,
+		Date:     date,
+		HomeTeam: nba.Team{Code: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Score: awayScore},
+		Status:   "Final",
+	}
+}
+
+func scheduled(date, home, away string) nba.Game {
+	return nba.Game{Date: date, HomeTeam: nba.Team{Code: home}, AwayTeam: nba.Team{Code: away}, Status: "Scheduled"}
+}
+
+// BOS 2-1, NYK 1-1, LAL 1-2
+func playedGames() []nba.Game {
+	return []nba.Game{
+		final("2024-01-01", "BOS", 110, "LAL", 100),
+		final("2024-01-02", "BOS", 105, "NYK", 100),
+		final("2024-01-03", "NYK", 100, "LAL", 90),
+		final("2024-01-04", "LAL", 100, "BOS", 95),
+	}
+}
+
+func findStanding(t *testing.T, standings *Standings, code string) Standing {
+	t.Helper()
+	for _, team := range standings.Teams {
+		if team.Team == code {
+			return team
+		}
+	}
+	require.Failf(t, "team not found", "no standing for %s", code)
+	return Standing{}
+}
+
+func TestBuild(t *testing.T) {
+	standings := Build(nba.NBA, playedGames(), "2023-24")
+
+	assert.Len(t, standings.Teams, len(nba.Teams(nba.NBA)))
+	assert.Equal(t, "East", standings.Teams[0].Group, "groups in order")
+	assert.Equal(t, "BOS", standings.Teams[0].Team)
+
+	bos := findStanding(t, standings, "BOS")
+	assert.Equal(t, 1, bos.Rank)
+	assert.Equal(t, "Boston Celtics", bos.Name)
+	assert.Equal(t, 2, bos.Wins)
+	assert.Equal(t, 1, bos.Losses)
+	assert.InDelta(t, 2.0/3, bos.WinPct, 1e-9)
+	assert.Equal(t, "2-0", bos.Home)
+	assert.Equal(t, "0-1", bos.Road)
+	assert.Equal(t, "2-1", bos.LastTen)
+	assert.Equal(t, "L1", bos.Streak)
+
+	nyk := findStanding(t, standings, "NYK")
+	assert.Equal(t, 2, nyk.Rank)
+	assert.InDelta(t, 0.5, nyk.GamesBack, 1e-9)
+
+	lal := findStanding(t, standings, "LAL")
+	assert.Equal(t, "West", lal.Group)
+	assert.Equal(t, 1, lal.Rank)
+	assert.Zero(t, lal.GamesBack)
+	assert.Nil(t, lal.Schedule)
+
+	standings.AddSchedules(BuildScheduleReport(nba.NBA, playedGames(), nil, nil, "2023-24"))
+	lal = findStanding(t, standings, "LAL")
+	require.NotNil(t, lal.Schedule)
+	assert.Equal(t, 3, lal.Schedule.Played.Games)
+}
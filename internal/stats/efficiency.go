@@ -0,0 +1,188 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Efficiency holds tempo-free team metrics. Ratings are points per 100
+// possessions and pace is possessions per regulation game.
+type Efficiency struct {
+	Possessions     float64 `json:"possessions"`
+	Pace            float64 `json:"pace"`
+	OffensiveRating float64 `json:"offensive_rating"`
+	DefensiveRating float64 `json:"defensive_rating"`
+	NetRating       float64 `json:"net_rating"`
+
+	// Four factors
+	EffectiveFGPct      float64 `json:"effective_fg_pct"`
+	TurnoverPct         float64 `json:"turnover_pct"`
+	OffensiveReboundPct float64 `json:"offensive_rebound_pct"`
+	FreeThrowRate       float64 `json:"free_throw_rate"` // Free throws made per field goal attempt
+	OppEffectiveFGPct   float64 `json:"opp_effective_fg_pct"`
+	OppTurnoverPct      float64 `json:"opp_turnover_pct"`
+	DefensiveReboundPct float64 `json:"defensive_rebound_pct"`
+	OppFreeThrowRate    float64 `json:"opp_free_throw_rate"`
+}
+
+// TeamGameStats is a team's box score and efficiency in one game
+type TeamGameStats struct {
+	GameID     string        `json:"game_id"`
+	Date       string        `json:"date"`
+	Team       string        `json:"team"`
+	Opponent   string        `json:"opponent"`
+	Home       bool          `json:"home"`
+	Minutes    int           `json:"minutes"`
+	Totals     nba.TeamStats `json:"totals"`
+	Opponents  nba.TeamStats `json:"opponent_totals"`
+	Efficiency Efficiency    `json:"efficiency"`
+}
+
+// TeamSeasonStats aggregates a team's games. Efficiency is computed from
+// summed totals, so games with more possessions carry more weight.
+type TeamSeasonStats struct {
+	Team       string        `json:"team"`
+	Games      int           `json:"games"`
+	Minutes    int           `json:"minutes"`
+	Totals     nba.TeamStats `json:"totals"`
+	Opponents  nba.TeamStats `json:"opponent_totals"`
+	Efficiency Efficiency    `json:"efficiency"`
+}
+
+// TeamStatsReport holds per-game and season team metrics
+type TeamStatsReport struct {
+	Period string            `json:"period"`
+	Season []TeamSeasonStats `json:"season"`
+	Games  []TeamGameStats   `json:"games,omitempty"`
+}
+
+// Possessions estimates one team's possessions from its box score
+func Possessions(s nba.TeamStats) float64 {
+	return float64(s.FieldGoalsAttempted) + 0.44*float64(s.FreeThrowsAttempted) -
+		float64(s.OffensiveRebounds) + float64(s.Turnovers)
+}
+
+// ComputeEfficiency calculates a team's metrics from its totals and its
+// opponents' totals over the given minutes. Possessions are the average of
+// both teams' estimates, since teams alternate possessions.
+func ComputeEfficiency(team, opponent nba.TeamStats, minutes, regulationMinutes int) Efficiency {
+	possessions := (Possessions(team) + Possessions(opponent)) / 2
+
+	e := Efficiency{
+		Possessions:         possessions,
+		Pace:                ratio(possessions*float64(regulationMinutes), float64(minutes)),
+		OffensiveRating:     ratio(100*float64(team.Points), possessions),
+		DefensiveRating:     ratio(100*float64(opponent.Points), possessions),
+		EffectiveFGPct:      effectiveFGPct(team),
+		TurnoverPct:         turnoverPct(team),
+		OffensiveReboundPct: ratio(float64(team.OffensiveRebounds), float64(team.OffensiveRebounds+opponent.DefensiveRebounds)),
+		FreeThrowRate:       ratio(float64(team.FreeThrowsMade), float64(team.FieldGoalsAttempted)),
+		OppEffectiveFGPct:   effectiveFGPct(opponent),
+		OppTurnoverPct:      turnoverPct(opponent),
+		DefensiveReboundPct: ratio(float64(team.DefensiveRebounds), float64(team.DefensiveRebounds+opponent.OffensiveRebounds)),
+		OppFreeThrowRate:    ratio(float64(opponent.FreeThrowsMade), float64(opponent.FieldGoalsAttempted)),
+	}
+	e.NetRating = e.OffensiveRating - e.DefensiveRating
+	return e
+}
+
+// effectiveFGPct weights three-pointers by the extra point they are worth
+func effectiveFGPct(s nba.TeamStats) float64 {
+	return ratio(float64(s.FieldGoalsMade)+0.5*float64(s.ThreePointersMade), float64(s.FieldGoalsAttempted))
+}
+
+// turnoverPct returns turnovers per play
+func turnoverPct(s nba.TeamStats) float64 {
+	return ratio(float64(s.Turnovers), float64(s.FieldGoalsAttempted)+0.44*float64(s.FreeThrowsAttempted)+float64(s.Turnovers))
+}
+
+// ratio divides a by b, returning 0 when b is 0
+func ratio(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return a / b
+}
+
+// BuildTeamStats computes both teams' metrics for every box score, and each
+// team's aggregate over all of them
+func BuildTeamStats(league nba.League, boxScores []nba.BoxScore, period string) *TeamStatsReport {
+	report := &TeamStatsReport{Period: period, Season: []TeamSeasonStats{}, Games: []TeamGameStats{}}
+	seasons := make(map[string]*TeamSeasonStats)
+	regulation := league.RegulationMinutes()
+
+	for _, boxScore := range boxScores {
+		for _, side := range []struct {
+			team, opponent nba.TeamBoxScore
+			home           bool
+		}{
+			{boxScore.HomeTeam, boxScore.AwayTeam, true},
+			{boxScore.AwayTeam, boxScore.HomeTeam, false},
+		} {
+			report.Games = append(report.Games, TeamGameStats{
+				GameID:     boxScore.GameID,
+				Date:       boxScore.Date,
+				Team:       side.team.Code,
+				Opponent:   side.opponent.Code,
+				Home:       side.home,
+				Minutes:    boxScore.Minutes,
+				Totals:     side.team.Totals,
+				Opponents:  side.opponent.Totals,
+				Efficiency: ComputeEfficiency(side.team.Totals, side.opponent.Totals, boxScore.Minutes, regulation),
+			})
+
+			season, ok := seasons[side.team.Code]
+			if !ok {
+				season = &TeamSeasonStats{Team: side.team.Code}
+				seasons[side.team.Code] = season
+			}
+			season.Games++
+			season.Minutes += boxScore.Minutes
+			season.Totals = season.Totals.Add(side.team.Totals)
+			season.Opponents = season.Opponents.Add(side.opponent.Totals)
+		}
+	}
+
+	for _, season := range seasons {
+		season.Efficiency = ComputeEfficiency(season.Totals, season.Opponents, season.Minutes, regulation)
+		report.Season = append(report.Season, *season)
+	}
+	sort.Slice(report.Season, func(i, j int) bool {
+		a, b := report.Season[i].Efficiency.NetRating, report.Season[j].Efficiency.NetRating
+		if a != b {
+			return a > b
+		}
+		return report.Season[i].Team < report.Season[j].Team
+	})
+	sort.SliceStable(report.Games, func(i, j int) bool {
+		return report.Games[i].Date < report.Games[j].Date
+	})
+
+	return report
+}
+
+// ForTeams returns the report restricted to the given teams
+func (r *TeamStatsReport) ForTeams(teams []string) *TeamStatsReport {
+	if len(teams) == 0 {
+		return r
+	}
+	wanted := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		wanted[strings.ToUpper(team)] = true
+	}
+
+	filtered := &TeamStatsReport{Period: r.Period, Season: []TeamSeasonStats{}, Games: []TeamGameStats{}}
+	for _, season := range r.Season {
+		if wanted[season.Team] {
+			filtered.Season = append(filtered.Season, season)
+		}
+	}
+	for _, game := range r.Games {
+		if wanted[game.Team] {
+			filtered.Games = append(filtered.Games, game)
+		}
+	}
+	return filtered
+}
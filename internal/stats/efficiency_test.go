@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	homeTotals = nba.TeamStats{
+		Points: 110, FieldGoalsMade: 40, FieldGoalsAttempted: 85, ThreePointersMade: 12,
+		FreeThrowsMade: 18, FreeThrowsAttempted: 22, OffensiveRebounds: 10, DefensiveRebounds: 34, Turnovers: 13,
+	}
+	awayTotals = nba.TeamStats{
+		Points: 100, FieldGoalsMade: 38, FieldGoalsAttempted: 88, ThreePointersMade: 10,
+		FreeThrowsMade: 14, FreeThrowsAttempted: 20, OffensiveRebounds: 11, DefensiveRebounds: 32, Turnovers: 12,
+	}
+)
+
+func TestComputeEfficiency(t *testing.T) {
+	e := ComputeEfficiency(homeTotals, awayTotals, 48, 48)
+
+	// (85 + 9.68 - 10 + 13 + 88 + 8.8 - 11 + 12) / 2
+	assert.InDelta(t, 97.74, e.Possessions, 0.001)
+	assert.InDelta(t, 97.74, e.Pace, 0.001)
+	assert.InDelta(t, 112.54, e.OffensiveRating, 0.01)
+	assert.InDelta(t, 102.31, e.DefensiveRating, 0.01)
+	assert.InDelta(t, e.OffensiveRating-e.DefensiveRating, e.NetRating, 1e-9)
+	assert.InDelta(t, 46.0/85, e.EffectiveFGPct, 1e-9)
+	assert.InDelta(t, 13/(85+9.68+13), e.TurnoverPct, 1e-9)
+	assert.InDelta(t, 10.0/42, e.OffensiveReboundPct, 1e-9)
+	assert.InDelta(t, 18.0/85, e.FreeThrowRate, 1e-9)
+	assert.InDelta(t, 34.0/45, e.DefensiveReboundPct, 1e-9)
+
+	// Overtime stretches the game, lowering pace but not ratings
+	overtime := ComputeEfficiency(homeTotals, awayTotals, 53, 48)
+	assert.Less(t, overtime.Pace, e.Pace)
+	assert.Equal(t, e.OffensiveRating, overtime.OffensiveRating)
+
+	assert.Zero(t, ComputeEfficiency(nba.TeamStats{}, nba.TeamStats{}, 0, 48).OffensiveRating)
+}
+
+func TestBuildTeamStats(t *testing.T) {
+	boxScore := nba.BoxScore{
+		GameID:   "1",
+		Date:     "2024-01-02",
+		Minutes:  48,
+		HomeTeam: nba.TeamBoxScore{Code: "BOS", Totals: homeTotals},
+		AwayTeam: nba.TeamBoxScore{Code: "LAL", Totals: awayTotals},
+	}
+	second := boxScore
+	second.GameID, second.Date = "2", "2024-01-05"
+
+	report := BuildTeamStats(nba.NBA, []nba.BoxScore{boxScore, second}, "2023-24")
+
+	require.Len(t, report.Games, 4)
+	assert.Equal(t, "BOS", report.Games[0].Team)
+	assert.True(t, report.Games[0].Home)
+	assert.Equal(t, "LAL", report.Games[0].Opponent)
+
+	require.Len(t, report.Season, 2)
+	bos := report.Season[0]
+	assert.Equal(t, "BOS", bos.Team, "ordered by net rating")
+	assert.Equal(t, 2, bos.Games)
+	assert.Equal(t, 220, bos.Totals.Points)
+	assert.InDelta(t, report.Games[0].Efficiency.Pace, bos.Efficiency.Pace, 1e-9)
+	assert.InDelta(t, report.Games[0].Efficiency.OffensiveRating, bos.Efficiency.OffensiveRating, 1e-9)
+	assert.InDelta(t, -bos.Efficiency.NetRating, report.Season[1].Efficiency.NetRating, 1e-9)
+
+	filtered := report.ForTeams([]string{"lal"})
+	require.Len(t, filtered.Season, 1)
+	assert.Equal(t, "LAL", filtered.Season[0].Team)
+	assert.Len(t, filtered.Games, 2)
+	assert.Same(t, report, report.ForTeams(nil))
+}
@@ -0,0 +1,237 @@
+package stats
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// LongTripMiles is the travel distance from which a trip counts as long
+const LongTripMiles = 1000.0
+
+// FatigueLookbackDays is how many days before a set of games are needed to
+// know the rest and travel of their first games
+const FatigueLookbackDays = 6
+
+// Fatigue conditions, as reported by BuildFatigueReport
+const (
+	ConditionRested           = "Rested (2+ days)"
+	ConditionOneDay           = "One day of rest"
+	ConditionBackToBack       = "Back-to-back"
+	ConditionThreeInFour      = "3-in-4"
+	ConditionFourInSix        = "4-in-6"
+	ConditionLongTrip         = "Long trip (1000+ mi)"
+	ConditionTimeZones        = "2+ time zones"
+	ConditionRestAdvantage    = "Rest advantage"
+	ConditionRestDisadvantage = "Rest disadvantage"
+)
+
+// fatigueCondition decides whether a condition applies to a team, given its
+// fatigue and its opponent's
+type fatigueCondition struct {
+	name    string
+	applies func(team, opponent nba.TeamFatigue) bool
+}
+
+var fatigueConditions = []fatigueCondition{
+	{ConditionRested, func(t, _ nba.TeamFatigue) bool { return t.RestDays >= 2 }},
+	{ConditionOneDay, func(t, _ nba.TeamFatigue) bool { return t.RestDays == 1 }},
+	{ConditionBackToBack, func(t, _ nba.TeamFatigue) bool { return t.BackToBack }},
+	{ConditionThreeInFour, func(t, _ nba.TeamFatigue) bool { return t.ThreeInFour }},
+	{ConditionFourInSix, func(t, _ nba.TeamFatigue) bool { return t.FourInSix }},
+	{ConditionLongTrip, func(t, _ nba.TeamFatigue) bool { return t.TravelMiles >= LongTripMiles }},
+	{ConditionTimeZones, func(t, _ nba.TeamFatigue) bool { return t.TimeZones >= 2 }},
+	{ConditionRestAdvantage, func(t, o nba.TeamFatigue) bool {
+		return t.RestDays >= 0 && o.RestDays >= 0 && t.RestDays > o.RestDays
+	}},
+	{ConditionRestDisadvantage, func(t, o nba.TeamFatigue) bool {
+		return t.RestDays >= 0 && o.RestDays >= 0 && t.RestDays < o.RestDays
+	}},
+}
+
+// FatigueSplit is a record under one fatigue condition
+type FatigueSplit struct {
+	Condition string  `json:"condition"`
+	Games     int     `json:"games"`
+	Wins      int     `json:"wins"`
+	Losses    int     `json:"losses"`
+	WinPct    float64 `json:"win_pct"`
+	AvgMargin float64 `json:"avg_margin"` // Points for minus against per game
+
+	totalMargin int
+}
+
+func (s *FatigueSplit) add(won bool, margin int) {
+	s.Games++
+	if won {
+		s.Wins++
+	} else {
+		s.Losses++
+	}
+	s.totalMargin += margin
+	s.WinPct = float64(s.Wins) / float64(s.Games)
+	s.AvgMargin = float64(s.totalMargin) / float64(s.Games)
+}
+
+// TeamFatigueSplits is one team's records under each fatigue condition
+type TeamFatigueSplits struct {
+	Team        string         `json:"team"`
+	Games       int            `json:"games"`
+	TravelMiles float64        `json:"travel_miles"` // Total over the games
+	Splits      []FatigueSplit `json:"splits"`
+}
+
+// FatigueReport shows how teams perform under each fatigue condition
+type FatigueReport struct {
+	Period string              `json:"period"`
+	League []FatigueSplit      `json:"league"`
+	Teams  []TeamFatigueSplits `json:"teams"`
+}
+
+// AddFatigue sets the rest and travel of both teams in every game. History
+// holds earlier games, such as the days before the first game, that count
+// towards rest and travel but are not annotated; it may overlap with games.
+// Games of any status count, so scheduled games have fatigue too.
+func AddFatigue(league nba.League, games, history []nba.Game) {
+	// Every team's schedule, in date order
+	seen := make(map[string]bool)
+	var timeline []nba.Game
+	for _, game := range append(append([]nba.Game(nil), history...), games...) {
+		key := game.Date + "/" + game.GameID
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		timeline = append(timeline, game)
+	}
+	nba.SortGames(timeline)
+
+	schedules := make(map[string][]nba.Game)
+	for _, game := range timeline {
+		for _, code := range []string{game.HomeTeam.Code, game.AwayTeam.Code} {
+			code = strings.ToUpper(code)
+			schedules[code] = append(schedules[code], game)
+		}
+	}
+
+	for i := range games {
+		game := games[i]
+		games[i].Fatigue = &nba.Fatigue{
+			Home: teamFatigue(league, game, strings.ToUpper(game.HomeTeam.Code), schedules[strings.ToUpper(game.HomeTeam.Code)]),
+			Away: teamFatigue(league, game, strings.ToUpper(game.AwayTeam.Code), schedules[strings.ToUpper(game.AwayTeam.Code)]),
+		}
+	}
+}
+
+// teamFatigue works out a team's rest and travel before a game from its schedule
+func teamFatigue(league nba.League, game nba.Game, team string, schedule []nba.Game) nba.TeamFatigue {
+	fatigue := nba.TeamFatigue{RestDays: -1}
+	date, err := time.Parse("2006-01-02", game.Date)
+	if err != nil {
+		return fatigue
+	}
+
+	var previous *nba.Game
+	inFour, inSix := 1, 1
+	for i := range schedule {
+		earlier := schedule[i]
+		if earlier.Date >= game.Date {
+			break
+		}
+		previous = &schedule[i]
+
+		played, err := time.Parse("2006-01-02", earlier.Date)
+		if err != nil {
+			continue
+		}
+		daysBefore := int(date.Sub(played).Hours() / 24)
+		if daysBefore <= 3 {
+			inFour++
+		}
+		if daysBefore <= 5 {
+			inSix++
+		}
+	}
+	fatigue.ThreeInFour = inFour >= 3
+	fatigue.FourInSix = inSix >= 4
+
+	// Teams start the period from home
+	from := team
+	if previous != nil {
+		from = previous.HomeTeam.Code
+		if played, err := time.Parse("2006-01-02", previous.Date); err == nil {
+			fatigue.RestDays = int(date.Sub(played).Hours()/24) - 1
+			fatigue.BackToBack = fatigue.RestDays == 0
+		}
+	}
+	origin, okFrom := nba.LookupArena(league, from)
+	destination, okTo := nba.LookupArena(league, game.HomeTeam.Code)
+	if okFrom && okTo {
+		fatigue.TravelMiles = round1(nba.DistanceMiles(origin, destination))
+		fatigue.TimeZones = absInt(destination.UTCOffset - origin.UTCOffset)
+	}
+	return fatigue
+}
+
+// BuildFatigueReport records how every team did under each fatigue
+// condition in the completed games, which must carry fatigue
+func BuildFatigueReport(games []nba.Game, period string) *FatigueReport {
+	league := newSplits()
+	teams := make(map[string]*TeamFatigueSplits)
+
+	for _, game := range games {
+		winner, ok := game.Winner()
+		if !ok || game.Fatigue == nil {
+			continue
+		}
+		sides := []struct {
+			team           nba.Team
+			self, opponent nba.TeamFatigue
+			margin         int
+		}{
+			{game.HomeTeam, game.Fatigue.Home, game.Fatigue.Away, game.HomeTeam.Score - game.AwayTeam.Score},
+			{game.AwayTeam, game.Fatigue.Away, game.Fatigue.Home, game.AwayTeam.Score - game.HomeTeam.Score},
+		}
+		for _, side := range sides {
+			code := strings.ToUpper(side.team.Code)
+			if teams[code] == nil {
+				teams[code] = &TeamFatigueSplits{Team: code, Splits: newSplits()}
+			}
+			team := teams[code]
+			team.Games++
+			team.TravelMiles = round1(team.TravelMiles + side.self.TravelMiles)
+
+			won := strings.EqualFold(winner.Code, code)
+			for i, condition := range fatigueConditions {
+				if condition.applies(side.self, side.opponent) {
+					league[i].add(won, side.margin)
+					team.Splits[i].add(won, side.margin)
+				}
+			}
+		}
+	}
+
+	report := &FatigueReport{Period: period, League: league}
+	for _, team := range teams {
+		report.Teams = append(report.Teams, *team)
+	}
+	sort.Slice(report.Teams, func(i, j int) bool { return report.Teams[i].Team < report.Teams[j].Team })
+	return report
+}
+
+// newSplits returns an empty split for every fatigue condition
+func newSplits() []FatigueSplit {
+	splits := make([]FatigueSplit, len(fatigueConditions))
+	for i, condition := range fatigueConditions {
+		splits[i].Condition = condition.name
+	}
+	return splits
+}
+
+// round1 rounds to one decimal place
+func round1(value float64) float64 {
+	return math.Round(value*10) / 10
+}
@@ -0,0 +1,93 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fatigueGame(date, home string, homeScore int, away string, awayScore int) nba.Game {
+	return nba.Game{
+		GameID:   date + home,
+		Date:     date,
+		HomeTeam: nba.Team{Code: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Score: awayScore},
+		Status:   "Final",
+	}
+}
+
+func TestAddFatigue(t *testing.T) {
+	history := []nba.Game{
+		fatigueGame("2024-01-09", "BOS", 110, "LAL", 100),
+	}
+	games := []nba.Game{
+		fatigueGame("2024-01-10", "NYK", 100, "LAL", 105), // B2B, Boston to New York
+		fatigueGame("2024-01-12", "LAL", 120, "MIA", 111), // 3-in-4, back to LA
+		fatigueGame("2024-01-13", "LAL", 99, "DEN", 101),  // B2B, 4-in-6 at home
+		fatigueGame("2024-01-13", "BOS", 99, "MIA", 90),   // BOS last played on the 9th
+	}
+
+	AddFatigue(nba.NBA, games, history)
+
+	atNewYork := games[0].Fatigue.Away
+	assert.Equal(t, 0, atNewYork.RestDays)
+	assert.True(t, atNewYork.BackToBack)
+	assert.False(t, atNewYork.ThreeInFour)
+	assert.InDelta(t, 190, atNewYork.TravelMiles, 10)
+	assert.Equal(t, 0, atNewYork.TimeZones)
+	assert.Equal(t, -1, games[0].Fatigue.Home.RestDays, "no earlier NYK game")
+
+	home := games[1].Fatigue.Home
+	assert.Equal(t, 1, home.RestDays)
+	assert.False(t, home.BackToBack)
+	assert.True(t, home.ThreeInFour)
+	assert.Greater(t, home.TravelMiles, 2000.0)
+	assert.Equal(t, 3, home.TimeZones)
+	assert.Equal(t, -1, games[1].Fatigue.Away.RestDays)
+	assert.Greater(t, games[1].Fatigue.Away.TravelMiles, 2000.0, "first game travels from home")
+
+	homeAgain := games[2].Fatigue.Home
+	assert.True(t, homeAgain.BackToBack)
+	assert.True(t, homeAgain.ThreeInFour)
+	assert.True(t, homeAgain.FourInSix)
+	assert.Zero(t, homeAgain.TravelMiles)
+	assert.Equal(t, "B2B, 3-in-4, 4-in-6", homeAgain.Flags())
+
+	assert.Equal(t, 3, games[3].Fatigue.Home.RestDays)
+	assert.True(t, games[3].Fatigue.Away.BackToBack)
+	assert.Nil(t, history[0].Fatigue, "history is not annotated")
+}
+
+func TestBuildFatigueReport(t *testing.T) {
+	games := []nba.Game{
+		fatigueGame("2024-01-10", "BOS", 110, "LAL", 100),
+		fatigueGame("2024-01-11", "NYK", 100, "LAL", 105),
+		fatigueGame("2024-01-14", "LAL", 120, "NYK", 110),
+	}
+	AddFatigue(nba.NBA, games, nil)
+
+	fatigue := BuildFatigueReport(games, "January")
+
+	splits := make(map[string]FatigueSplit)
+	for _, split := range fatigue.League {
+		splits[split.Condition] = split
+	}
+	backToBack := splits[ConditionBackToBack]
+	assert.Equal(t, 1, backToBack.Games)
+	assert.Equal(t, 1, backToBack.Wins)
+	assert.InDelta(t, 5, backToBack.AvgMargin, 0.001)
+
+	rested := splits[ConditionRested]
+	assert.Equal(t, 2, rested.Games, "LAL and NYK both rested two days")
+	assert.Equal(t, 1, rested.Wins)
+
+	assert.Equal(t, 3, splits[ConditionLongTrip].Games, "LAL to Boston and back to Los Angeles, NYK to Los Angeles")
+
+	require.Len(t, fatigue.Teams, 3)
+	assert.Equal(t, "BOS", fatigue.Teams[0].Team)
+	assert.Equal(t, "LAL", fatigue.Teams[1].Team)
+	assert.Equal(t, 3, fatigue.Teams[1].Games)
+	assert.Len(t, fatigue.Teams[1].Splits, len(fatigueConditions))
+}
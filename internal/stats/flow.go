@@ -0,0 +1,102 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// AnalyzeFlow works out how the lead went back and forth in a final game
+// from its play-by-play: lead changes, ties, each team's largest lead,
+// longest scoring run and time leading, and the winner's comeback. It
+// returns nil when there is no play-by-play.
+func AnalyzeFlow(game nba.Game, playByPlay *nba.PlayByPlay, league nba.League) *nba.Flow {
+	if playByPlay == nil || len(playByPlay.Events) == 0 {
+		return nil
+	}
+
+	flow := &nba.Flow{}
+	var homeLeadAt, awayLeadAt nba.ScoringEvent
+	var run nba.ScoringRun
+	margins := make([]int, 0, len(playByPlay.Events))
+	periods, elapsed, margin := 4, 0, 0
+	for _, event := range playByPlay.Events {
+		// The score since the last basket held until this one
+		addLeadTime(flow, margin, event.Elapsed-elapsed)
+		elapsed, margin = event.Elapsed, event.Margin()
+		margins = append(margins, margin)
+		if event.Period > periods {
+			periods = event.Period
+		}
+
+		if margin > flow.HomeLargestLead {
+			flow.HomeLargestLead, homeLeadAt = margin, event
+		}
+		if -margin > flow.AwayLargestLead {
+			flow.AwayLargestLead, awayLeadAt = -margin, event
+		}
+
+		team := strings.ToUpper(event.Team)
+		if team != run.Team {
+			run = nba.ScoringRun{Team: team, Period: event.Period, Clock: event.Clock}
+		}
+		run.Points += event.Points
+		if game.IsHome(team) && run.Points > flow.HomeLongestRun.Points {
+			flow.HomeLongestRun = run
+		} else if !game.IsHome(team) && run.Points > flow.AwayLongestRun.Points {
+			flow.AwayLongestRun = run
+		}
+	}
+	if len(game.HomeTeam.Linescore) > periods {
+		periods = len(game.HomeTeam.Linescore)
+	}
+	addLeadTime(flow, margin, league.PeriodStart(periods+1)-elapsed)
+	flow.LeadChanges, flow.TimesTied = countLeadChanges(margins)
+
+	if winner, ok := game.Winner(); ok {
+		deficit, at := flow.AwayLargestLead, awayLeadAt
+		if !game.IsHome(winner.Code) {
+			deficit, at = flow.HomeLargestLead, homeLeadAt
+		}
+		if deficit > 0 {
+			flow.Comeback = &nba.Comeback{Team: strings.ToUpper(winner.Code), Deficit: deficit, Period: at.Period, Clock: at.Clock}
+		}
+	}
+	return flow
+}
+
+// addLeadTime credits the seconds played at a home margin to the leading
+// team, or to time tied
+func addLeadTime(flow *nba.Flow, margin, seconds int) {
+	if seconds <= 0 {
+		return
+	}
+	switch {
+	case margin > 0:
+		flow.HomeSecondsLeading += seconds
+	case margin < 0:
+		flow.AwaySecondsLeading += seconds
+	default:
+		flow.SecondsTied += seconds
+	}
+}
+
+// BiggestComebacks returns the games won from at least minDeficit points
+// down, the biggest comeback first, keeping the first n when n is positive.
+// Games without a flow are left out.
+func BiggestComebacks(games []nba.Game, minDeficit, n int) []nba.Game {
+	comebacks := []nba.Game{}
+	for _, game := range games {
+		if game.Flow != nil && game.Flow.Comeback != nil && game.Flow.Comeback.Deficit >= minDeficit {
+			comebacks = append(comebacks, game)
+		}
+	}
+	sort.SliceStable(comebacks, func(i, j int) bool {
+		return comebacks[i].Flow.Comeback.Deficit > comebacks[j].Flow.Comeback.Deficit
+	})
+	if n > 0 && len(comebacks) > n {
+		comebacks = comebacks[:n]
+	}
+	return comebacks
+}
@@ -0,0 +1,60 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeFlow(t *testing.T) {
+	game := final("2024-01-02", "BOS", 7, "LAL", 6)
+	pbp := &nba.PlayByPlay{Events: []nba.ScoringEvent{
+		{Period: 1, Clock: "11:00", Elapsed: 60, Team: "BOS", Points: 2, HomeScore: 2},
+		{Period: 1, Clock: "10:00", Elapsed: 120, Team: "LAL", Points: 3, HomeScore: 2, AwayScore: 3},
+		{Period: 1, Clock: "9:50", Elapsed: 130, Team: "LAL", Points: 2, HomeScore: 2, AwayScore: 5},
+		{Period: 3, Clock: "2:40", Elapsed: 2000, Team: "bos", Points: 1, HomeScore: 3, AwayScore: 5},
+		{Period: 3, Clock: "1:00", Elapsed: 2100, Team: "BOS", Points: 2, HomeScore: 5, AwayScore: 5},
+		{Period: 4, Clock: "4:40", Elapsed: 2600, Team: "LAL", Points: 1, HomeScore: 5, AwayScore: 6},
+		{Period: 4, Clock: "1:20", Elapsed: 2800, Team: "BOS", Points: 2, HomeScore: 7, AwayScore: 6},
+	}}
+
+	flow := AnalyzeFlow(game, pbp, nba.NBA)
+	require.NotNil(t, flow)
+	assert.Equal(t, 2, flow.LeadChanges)
+	assert.Equal(t, 1, flow.TimesTied)
+	assert.Equal(t, 2, flow.HomeLargestLead)
+	assert.Equal(t, 3, flow.AwayLargestLead)
+	assert.Equal(t, 140, flow.HomeSecondsLeading)
+	assert.Equal(t, 2180, flow.AwaySecondsLeading)
+	assert.Equal(t, 560, flow.SecondsTied, "before the first basket and from 5-5")
+	assert.Equal(t, nba.ScoringRun{Team: "BOS", Points: 3, Period: 3, Clock: "2:40"}, flow.HomeLongestRun)
+	assert.Equal(t, nba.ScoringRun{Team: "LAL", Points: 5, Period: 1, Clock: "10:00"}, flow.AwayLongestRun)
+	assert.Equal(t, &nba.Comeback{Team: "BOS", Deficit: 3, Period: 1, Clock: "9:50"}, flow.Comeback)
+
+	// Overtime adds to the time played
+	game.HomeTeam.Linescore = []int{2, 0, 3, 2, 0}
+	assert.Equal(t, 440, AnalyzeFlow(game, pbp, nba.NBA).HomeSecondsLeading)
+
+	wire := final("2024-01-02", "BOS", 2, "LAL", 0)
+	flow = AnalyzeFlow(wire, &nba.PlayByPlay{Events: pbp.Events[:1]}, nba.NBA)
+	assert.Nil(t, flow.Comeback, "never trailed")
+	assert.Nil(t, AnalyzeFlow(game, nil, nba.NBA))
+}
+
+func TestBiggestComebacks(t *testing.T) {
+	games := []nba.Game{
+		{GameID: "1", Flow: &nba.Flow{Comeback: &nba.Comeback{Deficit: 8}}},
+		{GameID: "2", Flow: &nba.Flow{}},
+		{GameID: "3"},
+		{GameID: "4", Flow: &nba.Flow{Comeback: &nba.Comeback{Deficit: 21}}},
+		{GameID: "5", Flow: &nba.Flow{Comeback: &nba.Comeback{Deficit: 3}}},
+	}
+
+	comebacks := BiggestComebacks(games, 5, 0)
+	require.Len(t, comebacks, 2)
+	assert.Equal(t, "4", comebacks[0].GameID)
+	assert.Equal(t, "1", comebacks[1].GameID)
+	assert.Len(t, BiggestComebacks(games, 0, 1), 1)
+}
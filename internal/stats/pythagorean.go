@@ -0,0 +1,149 @@
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Pythagorean defaults
+const (
+	DefaultPythagoreanExponent = 14.0 // Close to Daryl Morey's 13.91 for the NBA
+	DefaultLuckThreshold       = 3.0  // Wins above or below expectation that count as luck
+	DefaultCloseGameMargin     = 5
+)
+
+// Luck verdicts
+const (
+	VerdictOverperformer  = "overperformer"
+	VerdictUnderperformer = "underperformer"
+)
+
+// PythagoreanConfig holds the parameters of a Pythagorean analysis
+type PythagoreanConfig struct {
+	Exponent        float64 `json:"exponent"`
+	LuckThreshold   float64 `json:"luck_threshold"`
+	CloseGameMargin int     `json:"close_game_margin"`
+}
+
+// DefaultPythagoreanConfig returns parameters that suit NBA scoring
+func DefaultPythagoreanConfig() PythagoreanConfig {
+	return PythagoreanConfig{
+		Exponent:        DefaultPythagoreanExponent,
+		LuckThreshold:   DefaultLuckThreshold,
+		CloseGameMargin: DefaultCloseGameMargin,
+	}
+}
+
+// TeamLuck compares a team's record with the record its scoring margin suggests
+type TeamLuck struct {
+	Team           string  `json:"team"`
+	Games          int     `json:"games"`
+	Wins           int     `json:"wins"`
+	Losses         int     `json:"losses"`
+	WinPct         float64 `json:"win_pct"`
+	PointsFor      int     `json:"points_for"`
+	PointsAgainst  int     `json:"points_against"`
+	MarginPerGame  float64 `json:"margin_per_game"`
+	ExpectedWinPct float64 `json:"expected_win_pct"`
+	ExpectedWins   float64 `json:"expected_wins"`
+	Luck           float64 `json:"luck"` // Wins minus expected wins
+	Verdict        string  `json:"verdict,omitempty"`
+	CloseWins      int     `json:"close_wins"`
+	CloseLosses    int     `json:"close_losses"`
+	CloseWinPct    float64 `json:"close_win_pct"`
+	OtherWins      int     `json:"other_wins"` // Games decided by more than the close margin
+	OtherLosses    int     `json:"other_losses"`
+}
+
+// PythagoreanReport holds every team's expected record, luckiest first
+type PythagoreanReport struct {
+	Period string            `json:"period"`
+	Config PythagoreanConfig `json:"config"`
+	Teams  []TeamLuck        `json:"teams"`
+}
+
+// PythagoreanExpectation returns the win percentage expected from points
+// scored and allowed: PF^x / (PF^x + PA^x), worked out as 1 / (1 + (PA/PF)^x)
+// so season point totals cannot overflow with large exponents
+func PythagoreanExpectation(pointsFor, pointsAgainst int, exponent float64) float64 {
+	switch {
+	case pointsFor == 0 && pointsAgainst == 0:
+		return 0.5
+	case pointsFor == 0:
+		return 0
+	}
+	return 1 / (1 + math.Pow(float64(pointsAgainst)/float64(pointsFor), exponent))
+}
+
+// BuildPythagoreanReport compares every team's record over the completed
+// games in games with its Pythagorean expectation
+func BuildPythagoreanReport(games []nba.Game, config PythagoreanConfig, period string) *PythagoreanReport {
+	byTeam := make(map[string]*TeamLuck)
+	for _, game := range games {
+		winner, ok := game.Winner()
+		if !ok {
+			continue
+		}
+		isClose := game.Margin() <= config.CloseGameMargin
+
+		for _, code := range []string{game.HomeTeam.Code, game.AwayTeam.Code} {
+			luck, ok := byTeam[code]
+			if !ok {
+				luck = &TeamLuck{Team: code}
+				byTeam[code] = luck
+			}
+
+			team, opponent := game.TeamAndOpponent(code)
+			luck.Games++
+			luck.PointsFor += team.Score
+			luck.PointsAgainst += opponent.Score
+
+			won := winner.Code == code
+			switch {
+			case won && isClose:
+				luck.Wins++
+				luck.CloseWins++
+			case won:
+				luck.Wins++
+				luck.OtherWins++
+			case isClose:
+				luck.Losses++
+				luck.CloseLosses++
+			default:
+				luck.Losses++
+				luck.OtherLosses++
+			}
+		}
+	}
+
+	report := &PythagoreanReport{Period: period, Config: config, Teams: make([]TeamLuck, 0, len(byTeam))}
+	for _, luck := range byTeam {
+		games := float64(luck.Games)
+		luck.WinPct = float64(luck.Wins) / games
+		luck.MarginPerGame = float64(luck.PointsFor-luck.PointsAgainst) / games
+		luck.ExpectedWinPct = PythagoreanExpectation(luck.PointsFor, luck.PointsAgainst, config.Exponent)
+		luck.ExpectedWins = luck.ExpectedWinPct * games
+		luck.Luck = float64(luck.Wins) - luck.ExpectedWins
+		if closeGames := luck.CloseWins + luck.CloseLosses; closeGames > 0 {
+			luck.CloseWinPct = float64(luck.CloseWins) / float64(closeGames)
+		}
+
+		switch {
+		case luck.Luck >= config.LuckThreshold:
+			luck.Verdict = VerdictOverperformer
+		case luck.Luck <= -config.LuckThreshold:
+			luck.Verdict = VerdictUnderperformer
+		}
+		report.Teams = append(report.Teams, *luck)
+	}
+
+	sort.Slice(report.Teams, func(i, j int) bool {
+		if report.Teams[i].Luck != report.Teams[j].Luck {
+			return report.Teams[i].Luck > report.Teams[j].Luck
+		}
+		return report.Teams[i].Team < report.Teams[j].Team
+	})
+	return report
+}
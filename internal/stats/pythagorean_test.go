@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPythagoreanExpectation(t *testing.T) {
+	assert.InDelta(t, 0.5, PythagoreanExpectation(100, 100, 14), 1e-9)
+	assert.InDelta(t, 0.5, PythagoreanExpectation(0, 0, 14), 1e-9)
+	assert.InDelta(t, 0.8, PythagoreanExpectation(2, 1, 2), 1e-9)
+	assert.Greater(t, PythagoreanExpectation(110, 100, 16.5), PythagoreanExpectation(110, 100, 14))
+	assert.Zero(t, PythagoreanExpectation(0, 100, 14))
+
+	// Season totals with a large exponent would overflow PF^x
+	assert.InDelta(t, 1, PythagoreanExpectation(9000, 8800, 500), 1e-4)
+	assert.InDelta(t, 0, PythagoreanExpectation(8800, 9000, 500), 1e-4)
+	assert.InDelta(t, 0.5, PythagoreanExpectation(9000, 9000, 500), 1e-9)
+}
+
+func TestBuildPythagoreanReport(t *testing.T) {
+	// BOS wins three close games and loses one blowout; LAL the reverse
+	games := []nba.Game{
+		final("2024-01-01", "BOS", 101, "LAL", 100),
+		final("2024-01-02", "LAL", 100, "BOS", 102),
+		final("2024-01-03", "BOS", 99, "LAL", 95),
+		final("2024-01-04", "LAL", 130, "BOS", 100),
+		{HomeTeam: nba.Team{Code: "BOS"}, AwayTeam: nba.Team{Code: "LAL"}, Status: "Scheduled"},
+	}
+
+	config := DefaultPythagoreanConfig()
+	config.LuckThreshold = 1
+	report := BuildPythagoreanReport(games, config, "January")
+
+	require.Len(t, report.Teams, 2)
+	bos := report.Teams[0]
+	assert.Equal(t, "BOS", bos.Team, "luckiest first")
+	assert.Equal(t, 4, bos.Games)
+	assert.Equal(t, 3, bos.Wins)
+	assert.Equal(t, 402, bos.PointsFor)
+	assert.Equal(t, 425, bos.PointsAgainst)
+	assert.Less(t, bos.ExpectedWinPct, 0.5)
+	assert.InDelta(t, 3-bos.ExpectedWins, bos.Luck, 1e-9)
+	assert.Equal(t, VerdictOverperformer, bos.Verdict)
+	assert.Equal(t, 3, bos.CloseWins)
+	assert.Equal(t, 0, bos.CloseLosses)
+	assert.Equal(t, 1, bos.OtherLosses)
+	assert.InDelta(t, 1, bos.CloseWinPct, 1e-9)
+
+	lal := report.Teams[1]
+	assert.Equal(t, VerdictUnderperformer, lal.Verdict)
+	assert.InDelta(t, -bos.Luck, lal.Luck, 1e-9)
+	assert.Equal(t, 3, lal.CloseLosses)
+}
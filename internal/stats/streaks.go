@@ -0,0 +1,239 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// DefaultWinMilestones are the win totals for which "first to N wins" is reported
+var DefaultWinMilestones = []int{10, 20, 30, 40, 50, 60}
+
+// Milestone kinds
+const (
+	MilestoneFirstToWins          = "first_to_wins"
+	MilestoneLongestActiveStreak  = "longest_active_streak"
+	MilestoneLongestWinningStreak = "longest_winning_streak"
+	MilestoneLongestLosingStreak  = "longest_losing_streak"
+)
+
+// Streak is a run of consecutive wins or losses
+type Streak struct {
+	Result    string `json:"result,omitempty"` // W or L
+	Length    int    `json:"length"`
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+}
+
+// String formats the streak as e.g. "W5", or "-" when empty
+func (s Streak) String() string {
+	if s.Length == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%s%d", s.Result, s.Length)
+}
+
+// StreakSet holds the current and longest streaks of one split of games
+type StreakSet struct {
+	Current     Streak `json:"current"`
+	LongestWin  Streak `json:"longest_win"`
+	LongestLoss Streak `json:"longest_loss"`
+}
+
+// add extends the set with the result of a game played on date
+func (s *StreakSet) add(result, date string) {
+	if s.Current.Result == result {
+		s.Current.Length++
+		s.Current.EndDate = date
+	} else {
+		s.Current = Streak{Result: result, Length: 1, StartDate: date, EndDate: date}
+	}
+
+	if result == "W" && s.Current.Length > s.LongestWin.Length {
+		s.LongestWin = s.Current
+	}
+	if result == "L" && s.Current.Length > s.LongestLoss.Length {
+		s.LongestLoss = s.Current
+	}
+}
+
+// TeamStreaks holds a team's streaks overall and split by home and away games
+type TeamStreaks struct {
+	Team    string    `json:"team"`
+	Wins    int       `json:"wins"`
+	Losses  int       `json:"losses"`
+	Overall StreakSet `json:"overall"`
+	Home    StreakSet `json:"home"`
+	Away    StreakSet `json:"away"`
+}
+
+// Milestone is a notable record event, such as the first team to 50 wins
+type Milestone struct {
+	Date        string `json:"date,omitempty"`
+	Team        string `json:"team"`
+	Kind        string `json:"kind"`
+	Value       int    `json:"value"`
+	Description string `json:"description"`
+}
+
+// StreaksReport combines every team's streaks with the milestones reached
+type StreaksReport struct {
+	Teams      []TeamStreaks `json:"teams"`
+	Milestones []Milestone   `json:"milestones"`
+}
+
+// ComputeStreaks calculates the streaks of every team over the completed
+// games in games, ordered by team code
+func ComputeStreaks(games []nba.Game) []TeamStreaks {
+	byTeam := make(map[string]*TeamStreaks)
+	team := func(code string) *TeamStreaks {
+		if byTeam[code] == nil {
+			byTeam[code] = &TeamStreaks{Team: code}
+		}
+		return byTeam[code]
+	}
+
+	for _, game := range sortedFinalGames(games) {
+		winner, _ := game.Winner()
+		for _, code := range []string{game.HomeTeam.Code, game.AwayTeam.Code} {
+			result := "L"
+			if winner.Code == code {
+				result = "W"
+			}
+
+			streaks := team(code)
+			if result == "W" {
+				streaks.Wins++
+			} else {
+				streaks.Losses++
+			}
+			streaks.Overall.add(result, game.Date)
+			if game.IsHome(code) {
+				streaks.Home.add(result, game.Date)
+			} else {
+				streaks.Away.add(result, game.Date)
+			}
+		}
+	}
+
+	teams := make([]TeamStreaks, 0, len(byTeam))
+	for _, streaks := range byTeam {
+		teams = append(teams, *streaks)
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Team < teams[j].Team })
+	return teams
+}
+
+// DetectMilestones finds the first teams to reach each win total in
+// winMilestones, the longest active streak and the longest winning and
+// losing streaks over the completed games in games
+func DetectMilestones(games []nba.Game, winMilestones []int) []Milestone {
+	var milestones []Milestone
+
+	// First to N wins; teams reaching a total on the same date share it
+	wins := make(map[string]int)
+	reachedOn := make(map[int]string)
+	for _, game := range sortedFinalGames(games) {
+		winner, _ := game.Winner()
+		wins[winner.Code]++
+		for _, target := range winMilestones {
+			if wins[winner.Code] != target {
+				continue
+			}
+			if date, ok := reachedOn[target]; ok && date != game.Date {
+				continue
+			}
+			reachedOn[target] = game.Date
+			milestones = append(milestones, Milestone{
+				Date:        game.Date,
+				Team:        winner.Code,
+				Kind:        MilestoneFirstToWins,
+				Value:       target,
+				Description: fmt.Sprintf("%s first to %d wins", winner.Code, target),
+			})
+		}
+	}
+
+	teams := ComputeStreaks(games)
+	if best, ok := bestStreak(teams, func(t TeamStreaks) Streak { return t.Overall.Current }); ok {
+		kind := "winning"
+		if best.streak.Result == "L" {
+			kind = "losing"
+		}
+		milestones = append(milestones, Milestone{
+			Date:        best.streak.EndDate,
+			Team:        best.team,
+			Kind:        MilestoneLongestActiveStreak,
+			Value:       best.streak.Length,
+			Description: fmt.Sprintf("%s have the longest active streak: %d-game %s streak", best.team, best.streak.Length, kind),
+		})
+	}
+	if best, ok := bestStreak(teams, func(t TeamStreaks) Streak { return t.Overall.LongestWin }); ok {
+		milestones = append(milestones, Milestone{
+			Date:        best.streak.EndDate,
+			Team:        best.team,
+			Kind:        MilestoneLongestWinningStreak,
+			Value:       best.streak.Length,
+			Description: fmt.Sprintf("%s have the longest winning streak: %s (%s to %s)", best.team, gameCount(best.streak.Length), best.streak.StartDate, best.streak.EndDate),
+		})
+	}
+	if best, ok := bestStreak(teams, func(t TeamStreaks) Streak { return t.Overall.LongestLoss }); ok {
+		milestones = append(milestones, Milestone{
+			Date:        best.streak.EndDate,
+			Team:        best.team,
+			Kind:        MilestoneLongestLosingStreak,
+			Value:       best.streak.Length,
+			Description: fmt.Sprintf("%s have the longest losing streak: %s (%s to %s)", best.team, gameCount(best.streak.Length), best.streak.StartDate, best.streak.EndDate),
+		})
+	}
+
+	return milestones
+}
+
+// BuildStreaksReport computes team streaks and milestones over games
+func BuildStreaksReport(games []nba.Game, winMilestones []int) *StreaksReport {
+	return &StreaksReport{
+		Teams:      ComputeStreaks(games),
+		Milestones: DetectMilestones(games, winMilestones),
+	}
+}
+
+type teamStreak struct {
+	team   string
+	streak Streak
+}
+
+// bestStreak returns the longest streak selected from each team; ties go to
+// the streak that ended last, then to the team code
+func bestStreak(teams []TeamStreaks, selectStreak func(TeamStreaks) Streak) (teamStreak, bool) {
+	var best teamStreak
+	for _, team := range teams {
+		streak := selectStreak(team)
+		if streak.Length > best.streak.Length ||
+			(streak.Length == best.streak.Length && streak.Length > 0 && streak.EndDate > best.streak.EndDate) {
+			best = teamStreak{team: team.Team, streak: streak}
+		}
+	}
+	return best, best.streak.Length > 0
+}
+
+// sortedFinalGames returns the decided games in chronological order
+func sortedFinalGames(games []nba.Game) []nba.Game {
+	var final []nba.Game
+	for _, game := range games {
+		if _, ok := game.Winner(); ok {
+			final = append(final, game)
+		}
+	}
+	nba.SortGames(final)
+	return final
+}
+
+// gameCount formats a number of games, e.g. "1 game" or "5 games"
+func gameCount(n int) string {
+	if n == 1 {
+		return "1 game"
+	}
+	return fmt.Sprintf("%d games", n)
+}
@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// final builds a completed game between home and away on date
+func final(date, home string, homeScore int, away string, awayScore int) nba.Game {
+	return nba.Game{
+		GameID:   date + home + away,
+		Date:     date,
+		HomeTeam: nba.Team{Code: home, Score: homeScore},
+		AwayTeam: nba.Team{Code: away, Score: awayScore},
+		Status:   "Final",
+		Quarter:  4,
+	}
+}
+
+func findTeam(t *testing.T, teams []TeamStreaks, code string) TeamStreaks {
+	for _, team := range teams {
+		if team.Team == code {
+			return team
+		}
+	}
+	t.Fatalf("team %s not found", code)
+	return TeamStreaks{}
+}
+
+func streakTestGames() []nba.Game {
+	return []nba.Game{
+		final("2024-01-01", "LAL", 110, "BOS", 100),
+		final("2024-01-02", "BOS", 100, "LAL", 105),
+		final("2024-01-03", "LAL", 120, "MIA", 101),
+		final("2024-01-04", "MIA", 99, "LAL", 97),
+		final("2024-01-05", "LAL", 101, "BOS", 100),
+		{Date: "2024-01-06", HomeTeam: nba.Team{Code: "BOS"}, AwayTeam: nba.Team{Code: "LAL"}, Status: "Scheduled"},
+	}
+}
+
+func TestComputeStreaks(t *testing.T) {
+	teams := ComputeStreaks(streakTestGames())
+	require.Len(t, teams, 3)
+	assert.Equal(t, []string{"BOS", "LAL", "MIA"}, []string{teams[0].Team, teams[1].Team, teams[2].Team})
+
+	lal := findTeam(t, teams, "LAL")
+	assert.Equal(t, 4, lal.Wins)
+	assert.Equal(t, 1, lal.Losses)
+	assert.Equal(t, "W1", lal.Overall.Current.String())
+	assert.Equal(t, Streak{Result: "W", Length: 3, StartDate: "2024-01-01", EndDate: "2024-01-03"}, lal.Overall.LongestWin)
+	assert.Equal(t, 1, lal.Overall.LongestLoss.Length)
+	assert.Equal(t, "W3", lal.Home.Current.String())
+	assert.Equal(t, "L1", lal.Away.Current.String())
+	assert.Equal(t, 1, lal.Away.LongestWin.Length)
+
+	bos := findTeam(t, teams, "BOS")
+	assert.Equal(t, "L3", bos.Overall.Current.String())
+	assert.Equal(t, "-", bos.Overall.LongestWin.String())
+}
+
+func TestDetectMilestones(t *testing.T) {
+	milestones := DetectMilestones(streakTestGames(), []int{2, 4})
+
+	kinds := map[string][]Milestone{}
+	for _, milestone := range milestones {
+		kinds[milestone.Kind] = append(kinds[milestone.Kind], milestone)
+	}
+
+	require.Len(t, kinds[MilestoneFirstToWins], 2)
+	assert.Equal(t, "2024-01-02", kinds[MilestoneFirstToWins][0].Date)
+	assert.Equal(t, "LAL first to 2 wins", kinds[MilestoneFirstToWins][0].Description)
+	assert.Equal(t, "2024-01-05", kinds[MilestoneFirstToWins][1].Date)
+
+	require.Len(t, kinds[MilestoneLongestActiveStreak], 1)
+	assert.Equal(t, "BOS", kinds[MilestoneLongestActiveStreak][0].Team)
+	assert.Equal(t, 3, kinds[MilestoneLongestActiveStreak][0].Value)
+
+	require.Len(t, kinds[MilestoneLongestWinningStreak], 1)
+	assert.Equal(t, "LAL", kinds[MilestoneLongestWinningStreak][0].Team)
+	assert.Equal(t, "LAL have the longest winning streak: 3 games (2024-01-01 to 2024-01-03)", kinds[MilestoneLongestWinningStreak][0].Description)
+}
+
+func TestDetectMilestones_SharedDate(t *testing.T) {
+	games := []nba.Game{
+		final("2024-01-01", "LAL", 110, "BOS", 100),
+		final("2024-01-01", "MIA", 110, "CHI", 100),
+	}
+
+	var first []string
+	var losing string
+	for _, milestone := range DetectMilestones(games, []int{1}) {
+		switch milestone.Kind {
+		case MilestoneFirstToWins:
+			first = append(first, milestone.Team)
+		case MilestoneLongestLosingStreak:
+			losing = milestone.Description
+		}
+	}
+	assert.ElementsMatch(t, []string{"LAL", "MIA"}, first)
+	assert.Equal(t, "BOS have the longest losing streak: 1 game (2024-01-01 to 2024-01-01)", losing)
+}
@@ -0,0 +1,268 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// regulationPeriods is the number of quarters in a regulation game
+const regulationPeriods = 4
+
+// HomeCourt is how home teams did over a set of games
+type HomeCourt struct {
+	Games      int     `json:"games"`
+	HomeWins   int     `json:"home_wins"`
+	AwayWins   int     `json:"away_wins"`
+	HomeWinPct float64 `json:"home_win_pct"`
+	HomeMargin float64 `json:"home_margin"` // Home points minus away points per game
+}
+
+func (h *HomeCourt) add(game nba.Game) {
+	h.Games++
+	if game.HomeTeam.Score > game.AwayTeam.Score {
+		h.HomeWins++
+	} else {
+		h.AwayWins++
+	}
+	h.HomeMargin += float64(game.HomeTeam.Score - game.AwayTeam.Score)
+}
+
+func (h *HomeCourt) finish() {
+	if h.Games == 0 {
+		return
+	}
+	h.HomeWinPct = float64(h.HomeWins) / float64(h.Games)
+	h.HomeMargin = round1(h.HomeMargin / float64(h.Games))
+}
+
+// TeamHomeCourt compares a team's home and road results
+type TeamHomeCourt struct {
+	Team       string  `json:"team"`
+	HomeWins   int     `json:"home_wins"`
+	HomeLosses int     `json:"home_losses"`
+	HomeWinPct float64 `json:"home_win_pct"`
+	HomeMargin float64 `json:"home_margin"`
+	RoadWins   int     `json:"road_wins"`
+	RoadLosses int     `json:"road_losses"`
+	RoadWinPct float64 `json:"road_win_pct"`
+	RoadMargin float64 `json:"road_margin"`
+	Advantage  float64 `json:"advantage"` // Home margin minus road margin, once the team has played both
+}
+
+// QuarterScoring is the average points scored in one period
+type QuarterScoring struct {
+	Period string  `json:"period"` // "Q1" to "Q4", or "OT" for every overtime
+	Games  int     `json:"games"`  // Games with a linescore for the period
+	Home   float64 `json:"home"`   // Average home points
+	Away   float64 `json:"away"`   // Average away points
+	Total  float64 `json:"total"`  // Average points by both teams
+}
+
+// HalftimeLeads is how often the team ahead at halftime went on to win
+type HalftimeLeads struct {
+	Games   int     `json:"games"` // Games with a halftime leader
+	Held    int     `json:"held"`
+	Blown   int     `json:"blown"`
+	Tied    int     `json:"tied"` // Games level at halftime
+	HeldPct float64 `json:"held_pct"`
+}
+
+func (h *HalftimeLeads) add(game nba.Game) {
+	home, away, ok := halftimeScore(game)
+	if !ok {
+		return
+	}
+	switch {
+	case home == away:
+		h.Tied++
+	case (home > away) == (game.HomeTeam.Score > game.AwayTeam.Score):
+		h.Games++
+		h.Held++
+	default:
+		h.Games++
+		h.Blown++
+	}
+	if h.Games > 0 {
+		h.HeldPct = float64(h.Held) / float64(h.Games)
+	}
+}
+
+// WeeklyTrend summarises the games of one week, starting on a Monday
+type WeeklyTrend struct {
+	Week          int     `json:"week"`
+	StartDate     string  `json:"start_date"`
+	EndDate       string  `json:"end_date"`
+	Games         int     `json:"games"`
+	HomeWinPct    float64 `json:"home_win_pct"`
+	HomeMargin    float64 `json:"home_margin"`
+	PointsPerGame float64 `json:"points_per_game"` // Both teams combined
+	OvertimePct   float64 `json:"overtime_pct"`
+	HalftimeHeld  float64 `json:"halftime_held_pct"`
+}
+
+// TrendsReport holds home-court, scoring and overtime trends over a period
+type TrendsReport struct {
+	Period        string           `json:"period"`
+	Games         int              `json:"games"`
+	HomeCourt     HomeCourt        `json:"home_court"`
+	Teams         []TeamHomeCourt  `json:"teams"`
+	Quarters      []QuarterScoring `json:"quarters"`
+	OvertimeGames int              `json:"overtime_games"`
+	OvertimePct   float64          `json:"overtime_pct"`
+	Halftime      HalftimeLeads    `json:"halftime"`
+	Weekly        []WeeklyTrend    `json:"weekly"`
+}
+
+// BuildTrendsReport works out home-court advantage, scoring by quarter,
+// overtime frequency and halftime leads over the completed games, league-wide,
+// per team and by week. Quarter and halftime figures need linescores.
+func BuildTrendsReport(games []nba.Game, period string) *TrendsReport {
+	report := &TrendsReport{Period: period}
+
+	type teamSplit struct{ home, road HomeCourt }
+	teams := make(map[string]*teamSplit)
+	quarters := make([]QuarterScoring, regulationPeriods+1)
+	for i := range quarters {
+		quarters[i].Period = fmt.Sprintf("Q%d", i+1)
+	}
+	quarters[regulationPeriods].Period = "OT"
+
+	type week struct {
+		start            time.Time
+		homeCourt        HomeCourt
+		points, overtime int
+		halftime         HalftimeLeads
+	}
+	weeks := make(map[string]*week)
+
+	for _, game := range games {
+		if _, ok := game.Winner(); !ok {
+			continue
+		}
+		report.Games++
+		report.HomeCourt.add(game)
+
+		for _, code := range []string{game.HomeTeam.Code, game.AwayTeam.Code} {
+			code = strings.ToUpper(code)
+			if teams[code] == nil {
+				teams[code] = &teamSplit{}
+			}
+		}
+		teams[strings.ToUpper(game.HomeTeam.Code)].home.add(game)
+		teams[strings.ToUpper(game.AwayTeam.Code)].road.add(game)
+
+		home, away := game.HomeTeam.Linescore, game.AwayTeam.Linescore
+		if len(home) >= regulationPeriods && len(home) == len(away) {
+			for i := 0; i < regulationPeriods; i++ {
+				quarters[i].Games++
+				quarters[i].Home += float64(home[i])
+				quarters[i].Away += float64(away[i])
+			}
+			for i := regulationPeriods; i < len(home); i++ {
+				quarters[regulationPeriods].Games++
+				quarters[regulationPeriods].Home += float64(home[i])
+				quarters[regulationPeriods].Away += float64(away[i])
+			}
+		}
+		overtime := overtimes(game) > 0
+		if overtime {
+			report.OvertimeGames++
+		}
+		report.Halftime.add(game)
+
+		date, err := time.Parse("2006-01-02", game.Date)
+		if err != nil {
+			continue
+		}
+		start := date.AddDate(0, 0, -(int(date.Weekday())+6)%7)
+		key := start.Format("2006-01-02")
+		if weeks[key] == nil {
+			weeks[key] = &week{start: start}
+		}
+		w := weeks[key]
+		w.homeCourt.add(game)
+		w.points += game.HomeTeam.Score + game.AwayTeam.Score
+		if overtime {
+			w.overtime++
+		}
+		w.halftime.add(game)
+	}
+
+	report.HomeCourt.finish()
+	if report.Games > 0 {
+		report.OvertimePct = float64(report.OvertimeGames) / float64(report.Games)
+	}
+
+	for i := range quarters {
+		if games := float64(quarters[i].Games); games > 0 {
+			quarters[i].Home = round1(quarters[i].Home / games)
+			quarters[i].Away = round1(quarters[i].Away / games)
+			quarters[i].Total = round1(quarters[i].Home + quarters[i].Away)
+		}
+	}
+	report.Quarters = quarters
+
+	for code, split := range teams {
+		split.home.finish()
+		split.road.finish()
+		team := TeamHomeCourt{
+			Team:       code,
+			HomeWins:   split.home.HomeWins,
+			HomeLosses: split.home.AwayWins,
+			HomeWinPct: split.home.HomeWinPct,
+			HomeMargin: split.home.HomeMargin,
+			RoadWins:   split.road.AwayWins,
+			RoadLosses: split.road.HomeWins,
+			RoadMargin: -split.road.HomeMargin,
+		}
+		if split.road.Games > 0 {
+			team.RoadWinPct = float64(team.RoadWins) / float64(split.road.Games)
+		}
+		if split.home.Games > 0 && split.road.Games > 0 {
+			team.Advantage = round1(team.HomeMargin - team.RoadMargin)
+		}
+		report.Teams = append(report.Teams, team)
+	}
+	sort.Slice(report.Teams, func(i, j int) bool {
+		if report.Teams[i].Advantage != report.Teams[j].Advantage {
+			return report.Teams[i].Advantage > report.Teams[j].Advantage
+		}
+		return report.Teams[i].Team < report.Teams[j].Team
+	})
+
+	keys := make([]string, 0, len(weeks))
+	for key := range weeks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		w := weeks[key]
+		w.homeCourt.finish()
+		games := float64(w.homeCourt.Games)
+		report.Weekly = append(report.Weekly, WeeklyTrend{
+			Week:          i + 1,
+			StartDate:     key,
+			EndDate:       w.start.AddDate(0, 0, 6).Format("2006-01-02"),
+			Games:         w.homeCourt.Games,
+			HomeWinPct:    w.homeCourt.HomeWinPct,
+			HomeMargin:    w.homeCourt.HomeMargin,
+			PointsPerGame: round1(float64(w.points) / games),
+			OvertimePct:   float64(w.overtime) / games,
+			HalftimeHeld:  w.halftime.HeldPct,
+		})
+	}
+	return report
+}
+
+// halftimeScore adds up both teams' first two quarters
+func halftimeScore(game nba.Game) (home, away int, ok bool) {
+	if len(game.HomeTeam.Linescore) < 2 || len(game.AwayTeam.Linescore) < 2 {
+		return 0, 0, false
+	}
+	return game.HomeTeam.Linescore[0] + game.HomeTeam.Linescore[1],
+		game.AwayTeam.Linescore[0] + game.AwayTeam.Linescore[1], true
+}
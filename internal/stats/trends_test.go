@@ -0,0 +1,78 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withLinescores sets both teams' period scores, which must add up to the final score
+func withLinescores(game nba.Game, home, away []int) nba.Game {
+	game.HomeTeam.Linescore, game.AwayTeam.Linescore = home, away
+	game.Quarter = len(home)
+	return game
+}
+
+func TestBuildTrendsReport(t *testing.T) {
+	games := []nba.Game{
+		// Monday: BOS leads at half and wins at home
+		withLinescores(final("2024-01-01", "BOS", 110, "LAL", 100), []int{30, 30, 25, 25}, []int{25, 25, 25, 25}),
+		// Wednesday: LAL trails at half, wins on the road in overtime
+		withLinescores(final("2024-01-03", "BOS", 105, "LAL", 110), []int{30, 30, 20, 20, 5}, []int{20, 20, 30, 30, 10}),
+		// Next Monday: LAL wins at home, level at half
+		withLinescores(final("2024-01-08", "LAL", 100, "BOS", 90), []int{25, 25, 25, 25}, []int{25, 25, 20, 20}),
+		{HomeTeam: nba.Team{Code: "LAL"}, AwayTeam: nba.Team{Code: "BOS"}, Date: "2024-01-09", Status: "Scheduled"},
+	}
+
+	report := BuildTrendsReport(games, "January")
+
+	assert.Equal(t, 3, report.Games)
+	assert.Equal(t, 2, report.HomeCourt.HomeWins)
+	assert.Equal(t, 1, report.HomeCourt.AwayWins)
+	assert.InDelta(t, 2.0/3, report.HomeCourt.HomeWinPct, 1e-9)
+	assert.InDelta(t, 5, report.HomeCourt.HomeMargin, 1e-9)
+
+	assert.Equal(t, 1, report.OvertimeGames)
+	assert.InDelta(t, 1.0/3, report.OvertimePct, 1e-9)
+
+	assert.Equal(t, 2, report.Halftime.Games)
+	assert.Equal(t, 1, report.Halftime.Held)
+	assert.Equal(t, 1, report.Halftime.Blown)
+	assert.Equal(t, 1, report.Halftime.Tied)
+	assert.InDelta(t, 0.5, report.Halftime.HeldPct, 1e-9)
+
+	require.Len(t, report.Quarters, 5)
+	assert.Equal(t, "Q1", report.Quarters[0].Period)
+	assert.Equal(t, 3, report.Quarters[0].Games)
+	assert.InDelta(t, 85.0/3, report.Quarters[0].Home, 0.05)
+	assert.Equal(t, "OT", report.Quarters[4].Period)
+	assert.Equal(t, 1, report.Quarters[4].Games)
+	assert.InDelta(t, 15, report.Quarters[4].Total, 1e-9)
+
+	require.Len(t, report.Teams, 2)
+	bos := report.Teams[0]
+	assert.Equal(t, "BOS", bos.Team, "equal advantages in team order")
+	assert.Equal(t, 1, bos.HomeWins)
+	assert.Equal(t, 1, bos.HomeLosses)
+	assert.Equal(t, 1, bos.RoadLosses)
+	assert.InDelta(t, 2.5, bos.HomeMargin, 1e-9)
+	assert.InDelta(t, -10, bos.RoadMargin, 1e-9)
+	assert.InDelta(t, 12.5, bos.Advantage, 1e-9)
+
+	lal := report.Teams[1]
+	assert.Equal(t, 1, lal.HomeWins)
+	assert.Equal(t, 1, lal.RoadWins)
+	assert.InDelta(t, 0.5, lal.RoadWinPct, 1e-9)
+	assert.InDelta(t, 12.5, lal.Advantage, 1e-9)
+
+	require.Len(t, report.Weekly, 2)
+	assert.Equal(t, "2024-01-01", report.Weekly[0].StartDate)
+	assert.Equal(t, "2024-01-07", report.Weekly[0].EndDate)
+	assert.Equal(t, 2, report.Weekly[0].Games)
+	assert.InDelta(t, 212.5, report.Weekly[0].PointsPerGame, 1e-9)
+	assert.InDelta(t, 0.5, report.Weekly[0].OvertimePct, 1e-9)
+	assert.Equal(t, 2, report.Weekly[1].Week)
+	assert.InDelta(t, 1, report.Weekly[1].HomeWinPct, 1e-9)
+}
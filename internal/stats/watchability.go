@@ -0,0 +1,142 @@
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+)
+
+// Watchability weights, which add up to 100
+const (
+	watchMarginWeight      = 30.0 // Final margin of 0, falling to nothing at watchBlowout
+	watchOvertimeWeight    = 10.0 // Per overtime, up to two
+	watchLeadChangeWeight  = 20.0 // Reached at watchLeadChanges lead changes
+	watchTieWeight         = 10.0 // Reached at watchTies ties
+	watchLateMarginWeight  = 20.0 // Level in crunch time, falling to nothing at watchLateBlowout
+	watchBlowout           = 20.0
+	watchLateBlowout       = 15.0
+	watchLeadChanges       = 20.0
+	watchTies              = 12.0
+	watchCrunchTimeSeconds = 5 * 60
+)
+
+// Watchability sources
+const (
+	WatchabilityPlayByPlay = "play-by-play"
+	WatchabilityLinescore  = "linescore"
+)
+
+// RateWatchability scores how exciting a completed game was. Lead changes,
+// ties and the crunch-time margin come from play-by-play when available;
+// otherwise they are counted at the end of each period of the linescore,
+// with the margin after three quarters standing in for crunch time.
+func RateWatchability(game nba.Game, playByPlay *nba.PlayByPlay, league nba.League) nba.Watchability {
+	w := nba.Watchability{FinalMargin: game.Margin(), Overtimes: overtimes(game)}
+
+	var margins []int
+	switch {
+	case playByPlay != nil && len(playByPlay.Events) > 0:
+		w.Source = WatchabilityPlayByPlay
+		crunchTime := league.PeriodStart(5) - watchCrunchTimeSeconds
+		w.LateMargin = -1
+		for _, event := range playByPlay.Events {
+			// The margin when crunch time starts is the one before its first basket
+			if event.Elapsed > crunchTime && w.LateMargin < 0 {
+				w.LateMargin = 0
+				if len(margins) > 0 {
+					w.LateMargin = absInt(margins[len(margins)-1])
+				}
+			}
+			margins = append(margins, event.Margin())
+		}
+		if w.LateMargin < 0 {
+			w.LateMargin = w.FinalMargin
+		}
+	default:
+		w.Source = WatchabilityLinescore
+		home, away := 0, 0
+		for period := range game.HomeTeam.Linescore {
+			if period >= len(game.AwayTeam.Linescore) {
+				break
+			}
+			home += game.HomeTeam.Linescore[period]
+			away += game.AwayTeam.Linescore[period]
+			margins = append(margins, home-away)
+			if period == 2 {
+				w.LateMargin = absInt(home - away)
+			}
+		}
+		if len(margins) < 3 {
+			w.LateMargin = w.FinalMargin
+		}
+	}
+	w.LeadChanges, w.Ties = countLeadChanges(margins)
+
+	score := watchMarginWeight * math.Max(0, 1-float64(w.FinalMargin)/watchBlowout)
+	score += watchOvertimeWeight * math.Min(float64(w.Overtimes), 2)
+	score += watchLeadChangeWeight * math.Min(float64(w.LeadChanges)/watchLeadChanges, 1)
+	score += watchTieWeight * math.Min(float64(w.Ties)/watchTies, 1)
+	score += watchLateMarginWeight * math.Max(0, 1-float64(w.LateMargin)/watchLateBlowout)
+	w.Score = math.Round(math.Min(score, 100)*10) / 10
+	return w
+}
+
+// countLeadChanges counts how often the leading team changed and how often
+// the score was level, given the home margin after each scoring change
+func countLeadChanges(margins []int) (int, int) {
+	leadChanges, ties, leader := 0, 0, 0
+	for _, margin := range margins {
+		switch {
+		case margin == 0:
+			ties++
+		case margin > 0:
+			if leader < 0 {
+				leadChanges++
+			}
+			leader = 1
+		default:
+			if leader > 0 {
+				leadChanges++
+			}
+			leader = -1
+		}
+	}
+	return leadChanges, ties
+}
+
+// overtimes counts the extra periods of a game from its linescore, or from
+// its last quarter when there is none
+func overtimes(game nba.Game) int {
+	if periods := len(game.HomeTeam.Linescore); periods > 4 {
+		return periods - 4
+	} else if game.Quarter > 4 {
+		return game.Quarter - 4
+	}
+	return 0
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// BestGames returns the n most watchable games, most watchable first. Games
+// without a watchability score are left out.
+func BestGames(games []nba.Game, n int) []nba.Game {
+	best := []nba.Game{}
+	for _, game := range games {
+		if game.Watchability != nil {
+			best = append(best, game)
+		}
+	}
+	sort.SliceStable(best, func(i, j int) bool {
+		return best[i].Watchability.Score > best[j].Watchability.Score
+	})
+	if n > 0 && len(best) > n {
+		best = best[:n]
+	}
+	return best
+}
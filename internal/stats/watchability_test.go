@@ -0,0 +1,63 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/jeremielumandong/nba-result/internal/nba"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateWatchability_Linescore(t *testing.T) {
+	thriller := final("2024-01-02", "BOS", 120, "LAL", 118)
+	thriller.HomeTeam.Linescore = []int{25, 30, 25, 20, 10, 10}
+	thriller.AwayTeam.Linescore = []int{30, 25, 28, 17, 10, 8}
+
+	w := RateWatchability(thriller, nil, nba.NBA)
+	assert.Equal(t, WatchabilityLinescore, w.Source)
+	assert.Equal(t, 2, w.FinalMargin)
+	assert.Equal(t, 2, w.Overtimes)
+	assert.Equal(t, 1, w.LeadChanges) // -5, 0, -3, 0, 0, +2: LAL led, then BOS
+	assert.Equal(t, 3, w.Ties)
+	assert.Equal(t, 3, w.LateMargin)
+
+	blowout := final("2024-01-02", "BOS", 130, "LAL", 95)
+	blowout.HomeTeam.Linescore = []int{35, 30, 35, 30}
+	blowout.AwayTeam.Linescore = []int{20, 25, 25, 25}
+	assert.Greater(t, w.Score, RateWatchability(blowout, nil, nba.NBA).Score)
+	assert.Zero(t, RateWatchability(blowout, nil, nba.NBA).Score)
+}
+
+func TestRateWatchability_PlayByPlay(t *testing.T) {
+	game := final("2024-01-02", "BOS", 7, "LAL", 6)
+	pbp := &nba.PlayByPlay{Events: []nba.ScoringEvent{
+		{Elapsed: 60, Team: "BOS", Points: 2, HomeScore: 2},
+		{Elapsed: 120, Team: "LAL", Points: 3, HomeScore: 2, AwayScore: 3},
+		{Elapsed: 2000, Team: "BOS", Points: 1, HomeScore: 3, AwayScore: 3},
+		{Elapsed: 2600, Team: "LAL", Points: 3, HomeScore: 3, AwayScore: 6},
+		{Elapsed: 2800, Team: "BOS", Points: 4, HomeScore: 7, AwayScore: 6},
+	}}
+
+	w := RateWatchability(game, pbp, nba.NBA)
+	assert.Equal(t, WatchabilityPlayByPlay, w.Source)
+	assert.Equal(t, 2, w.LeadChanges)
+	assert.Equal(t, 1, w.Ties)
+	assert.Equal(t, 0, w.LateMargin, "level with five minutes left")
+	assert.Greater(t, w.Score, 50.0)
+	assert.LessOrEqual(t, w.Score, 100.0)
+}
+
+func TestBestGames(t *testing.T) {
+	games := []nba.Game{
+		{GameID: "1", Watchability: &nba.Watchability{Score: 40}},
+		{GameID: "2"},
+		{GameID: "3", Watchability: &nba.Watchability{Score: 90}},
+		{GameID: "4", Watchability: &nba.Watchability{Score: 60}},
+	}
+
+	best := BestGames(games, 2)
+	require.Len(t, best, 2)
+	assert.Equal(t, "3", best[0].GameID)
+	assert.Equal(t, "4", best[1].GameID)
+	assert.Len(t, BestGames(games, 0), 3)
+}
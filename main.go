@@ -13,6 +13,14 @@ import (
 )
 
 func main() {
+	// Subcommands (e.g., "h2h") have their own flags
+	if len(os.Args) > 1 {
+		if cmd, ok := findCommand(os.Args[1]); ok {
+			cmd.run(os.Args[2:])
+			return
+		}
+	}
+
 	// Command line flags
 	var (
 		outputFile = flag.String("output", "nba_results.json", "Output JSON file path")
@@ -20,6 +28,17 @@ func main() {
 		date       = flag.String("date", "", "Date in YYYY-MM-DD format (default: today)")
 		startDate  = flag.String("start-date", "", "Start date for range query (YYYY-MM-DD)")
 		endDate    = flag.String("end-date", "", "End date for range query (YYYY-MM-DD)")
+		leagueCode = flag.String("league", "nba", "League to query: nba, wnba or gleague")
+		teams      = flag.String("team", "", "Only include games involving these teams (e.g., LAL,BOS)")
+		conference = flag.String("conference", "", "Only include games involving a team from this conference")
+		division   = flag.String("division", "", "Only include games involving a team from this division")
+		status     = flag.String("status", "", "Only include games with these statuses (e.g., Final)")
+		predict    = flag.Bool("predict", false, "Predict win probabilities for a date's scheduled games (allows future dates)")
+		noSpoilers = flag.Bool("no-spoilers", false, "Hide scores, winners and margins; show status and watchability instead")
+		fatigue    = flag.Bool("fatigue", false, "Add each team's rest, back-to-backs and travel to every game")
+		feats      = flag.Bool("feats", false, "Tag double-doubles, triple-doubles and other feats, with season counts")
+		oddsFile   = flag.String("odds", "", "Closing lines CSV or JSON file to join by game ID, or by date and teams")
+		flow       = flag.Bool("flow", false, "Add lead changes, largest leads, scoring runs and comebacks from play-by-play")
 		help       = flag.Bool("help", false, "Show help message")
 	)
 	flag.Parse()
@@ -29,13 +48,44 @@ func main() {
 		return
 	}
 
-	// Create NBA client and date service
-	client := nba.NewClient()
+	league, err := nba.ParseLeague(*leagueCode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	// Create league client and date service
+	client := nba.NewLeagueClient(league)
 	dateService := nba.NewDateService(client)
 
+	filter := &nba.GameFilter{
+		League:     league,
+		Teams:      nba.ParseList(*teams),
+		Conference: *conference,
+		Division:   *division,
+		Statuses:   nba.ParseList(*status),
+	}
+	if err := filter.Validate(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	dateService.SetFilter(filter)
+
+	options := queryOptions{
+		outputFile: *outputFile,
+		excelFile:  *excelFile,
+		predict:    *predict,
+		noSpoilers: *noSpoilers,
+		fatigue:    *fatigue,
+		feats:      *feats,
+		oddsFile:   *oddsFile,
+		flow:       *flow,
+	}
+
 	// Handle date range query
 	if *startDate != "" && *endDate != "" {
-		handleDateRangeQuery(dateService, *startDate, *endDate, *outputFile, *excelFile)
+		if *predict {
+			log.Fatalf("Error: -predict works with -date only, not with -start-date and -end-date")
+		}
+		handleDateRangeQuery(dateService, *startDate, *endDate, options)
 		return
 	}
 
@@ -47,44 +97,95 @@ func main() {
 		targetDateStr = time.Now().Format("2006-01-02")
 	}
 
-	handleSingleDateQuery(dateService, targetDateStr, *outputFile, *excelFile)
+	handleSingleDateQuery(dateService, targetDateStr, options)
+}
+
+// queryOptions are the output settings of a date or date range query
+type queryOptions struct {
+	outputFile string
+	excelFile  string
+	predict    bool
+	noSpoilers bool
+	fatigue    bool
+	feats      bool
+	oddsFile   string
+	flow       bool
 }
 
-func handleSingleDateQuery(dateService *nba.DateService, dateStr, outputFile, excelFile string) {
-	fmt.Printf("Fetching NBA games for %s...\n", dateStr)
+func handleSingleDateQuery(dateService *nba.DateService, dateStr string, options queryOptions) {
+	fmt.Printf("Fetching %s games for %s...\n", dateService.League().Name, dateStr)
 
-	// Get games by date
-	result, err := dateService.GetGamesByDate(dateStr)
+	// Get games by date; predictions are for upcoming games, so allow future dates
+	var result *nba.GameResults
+	var err error
+	if options.predict {
+		result, err = dateService.GetScheduleByDate(dateStr)
+	} else {
+		result, err = dateService.GetGamesByDate(dateStr)
+	}
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", dateService.League().Name, err)
+	}
+	result.Summary.Highlights, err = streakHighlights(dateService, dateStr, result.Games)
 	if err != nil {
-		log.Fatalf("Error fetching NBA games: %v", err)
+		log.Fatalf("Error fetching %s games: %v", dateService.League().Name, err)
+	}
+
+	if options.predict {
+		if err := predictGames(dateService.League(), dateStr, result.Games); err != nil {
+			log.Fatalf("Error predicting games: %v", err)
+		}
+		printPredictions(result.Games)
+	}
+
+	addWatchability(dateService, result.Games)
+	if options.fatigue {
+		if err := addFatigue(dateService.League(), dateStr, result.Games); err != nil {
+			log.Fatalf("Error working out fatigue: %v", err)
+		}
+	}
+	if options.feats {
+		if err := addFeats(dateService.League(), dateStr, result.Games); err != nil {
+			log.Fatalf("Error detecting feats: %v", err)
+		}
+	}
+	if options.oddsFile != "" {
+		if err := addOdds(options.oddsFile, result.Games); err != nil {
+			log.Fatalf("Error joining odds: %v", err)
+		}
+	}
+	if options.flow {
+		addFlow(dateService, result.Games)
 	}
 
 	fmt.Printf("Found %d games\n", result.TotalGames)
 
 	// Print summary
-	printSummary(result.Summary)
+	printResults(result, options.noSpoilers)
 
 	// Save JSON result
-	if err := saveGameResultsJSON(result, outputFile); err != nil {
+	if err := saveGameResultsJSON(result, options.outputFile, options.noSpoilers); err != nil {
 		log.Fatalf("Error saving JSON: %v", err)
 	}
-	fmt.Printf("JSON results saved to: %s\n", outputFile)
+	fmt.Printf("JSON results saved to: %s\n", options.outputFile)
 
 	// Generate Excel report
 	reporter := report.NewExcelReporter()
-	if err := reporter.GenerateReport(result.Games, excelFile); err != nil {
+	reporter.SetSpoilerFree(options.noSpoilers)
+	if err := reporter.GenerateReport(result.Games, options.excelFile); err != nil {
 		log.Fatalf("Error generating Excel report: %v", err)
 	}
-	fmt.Printf("Excel report saved to: %s\n", excelFile)
+	fmt.Printf("Excel report saved to: %s\n", options.excelFile)
 }
 
-func handleDateRangeQuery(dateService *nba.DateService, startDate, endDate, outputFile, excelFile string) {
-	fmt.Printf("Fetching NBA games from %s to %s...\n", startDate, endDate)
+func handleDateRangeQuery(dateService *nba.DateService, startDate, endDate string, options queryOptions) {
+	league := dateService.League()
+	fmt.Printf("Fetching %s games from %s to %s...\n", league.Name, startDate, endDate)
 
 	// Get games by date range
 	results, err := dateService.GetGamesByDateRange(startDate, endDate)
 	if err != nil {
-		log.Fatalf("Error fetching NBA games for date range: %v", err)
+		log.Fatalf("Error fetching %s games for date range: %v", league.Name, err)
 	}
 
 	// Aggregate all games and create summary
@@ -100,39 +201,73 @@ func handleDateRangeQuery(dateService *nba.DateService, startDate, endDate, outp
 		aggregatedSummary.Scheduled += result.Summary.Scheduled
 		aggregatedSummary.Other += result.Summary.Other
 	}
+	aggregatedSummary.Highlights, err = streakHighlights(dateService, startDate, allGames)
+	if err != nil {
+		log.Fatalf("Error fetching %s games: %v", league.Name, err)
+	}
+	addWatchability(dateService, allGames)
+	if options.fatigue {
+		if err := addFatigue(league, startDate, allGames); err != nil {
+			log.Fatalf("Error working out fatigue: %v", err)
+		}
+	}
+	if options.feats {
+		if err := addFeats(league, startDate, allGames); err != nil {
+			log.Fatalf("Error detecting feats: %v", err)
+		}
+	}
+	if options.oddsFile != "" {
+		if err := addOdds(options.oddsFile, allGames); err != nil {
+			log.Fatalf("Error joining odds: %v", err)
+		}
+	}
+	if options.flow {
+		addFlow(dateService, allGames)
+	}
 
 	fmt.Printf("Found %d games across %d days\n", totalGames, len(results))
-	printSummary(aggregatedSummary)
 
 	// Create aggregated result for JSON export
 	aggregatedResult := &nba.GameResults{
 		Date:       fmt.Sprintf("%s to %s", startDate, endDate),
+		League:     league.Code,
 		Games:      allGames,
 		TotalGames: totalGames,
 		Summary:    aggregatedSummary,
 		Metadata: nba.ResultMetadata{
 			GeneratedAt: time.Now().Format(time.RFC3339),
-			Source:      "NBA API",
+			Source:      fmt.Sprintf("%s API", league.Name),
 			Version:     "1.0",
+			Filter:      dateService.Filter().String(),
 		},
 	}
 
+	printResults(aggregatedResult, options.noSpoilers)
+
 	// Save JSON result
-	if err := saveGameResultsJSON(aggregatedResult, outputFile); err != nil {
+	if err := saveGameResultsJSON(aggregatedResult, options.outputFile, options.noSpoilers); err != nil {
 		log.Fatalf("Error saving JSON: %v", err)
 	}
-	fmt.Printf("JSON results saved to: %s\n", outputFile)
+	fmt.Printf("JSON results saved to: %s\n", options.outputFile)
 
 	// Generate Excel report
 	reporter := report.NewExcelReporter()
-	if err := reporter.GenerateReport(allGames, excelFile); err != nil {
+	reporter.SetSpoilerFree(options.noSpoilers)
+	if err := reporter.GenerateReport(allGames, options.excelFile); err != nil {
 		log.Fatalf("Error generating Excel report: %v", err)
 	}
-	fmt.Printf("Excel report saved to: %s\n", excelFile)
+	fmt.Printf("Excel report saved to: %s\n", options.excelFile)
 }
 
-func saveGameResultsJSON(result *nba.GameResults, filename string) error {
-	data, err := json.MarshalIndent(result, "", "  ")
+func saveGameResultsJSON(result *nba.GameResults, filename string, noSpoilers bool) error {
+	if noSpoilers {
+		return saveJSON(result.SpoilerFree(), filename)
+	}
+	return saveJSON(result, filename)
+}
+
+func saveJSON(v interface{}, filename string) error {
+	data, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshaling JSON: %w", err)
 	}
@@ -140,6 +275,35 @@ func saveGameResultsJSON(result *nba.GameResults, filename string) error {
 	return os.WriteFile(filename, data, 0644)
 }
 
+// printResults prints the summary of a result set and any feats; without
+// spoilers the highlights and feats are left out and each game's status and
+// watchability are listed
+func printResults(result *nba.GameResults, noSpoilers bool) {
+	if !noSpoilers {
+		printSummary(result.Summary)
+		var feats []nba.Feat
+		for _, game := range result.Games {
+			feats = append(feats, game.Feats...)
+		}
+		printNotablePerformances(feats)
+		return
+	}
+
+	summary := result.Summary
+	summary.Highlights = nil
+	printSummary(summary)
+
+	fmt.Println("Games (spoiler-free):")
+	for _, game := range nba.SpoilerFreeGames(result.Games) {
+		watchability := "-"
+		if game.Watchability != nil {
+			watchability = fmt.Sprintf("%.0f/100", *game.Watchability)
+		}
+		fmt.Printf("  %s @ %s  %-9s  watchability %s\n", game.AwayTeam, game.HomeTeam, game.Status, watchability)
+	}
+	fmt.Println()
+}
+
 func printSummary(summary nba.GameSummary) {
 	fmt.Println("\nGame Summary:")
 	fmt.Printf("  Final: %d\n", summary.Final)
@@ -148,6 +312,12 @@ func printSummary(summary nba.GameSummary) {
 	if summary.Other > 0 {
 		fmt.Printf("  Other: %d\n", summary.Other)
 	}
+	if len(summary.Highlights) > 0 {
+		fmt.Println("\nHighlights:")
+		for _, highlight := range summary.Highlights {
+			fmt.Printf("  %s\n", highlight)
+		}
+	}
 	fmt.Println()
 }
 
@@ -155,7 +325,15 @@ func printHelp() {
 	fmt.Println("NBA Game Results Tracker")
 	fmt.Println("========================")
 	fmt.Println()
-	fmt.Println("Usage: go run main.go [options]")
+	fmt.Println("Usage: go run . [options]")
+	fmt.Println("       go run . <command> [options]")
+	fmt.Println()
+	fmt.Println("Commands:")
+	for _, cmd := range commands() {
+		fmt.Printf("  %-12s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Println()
+	fmt.Println("Run 'go run . <command> -h' for command options.")
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  -output string")
@@ -168,13 +346,65 @@ func printHelp() {
 	fmt.Println("        Start date for range query (YYYY-MM-DD)")
 	fmt.Println("  -end-date string")
 	fmt.Println("        End date for range query (YYYY-MM-DD)")
+	fmt.Println("  -league string")
+	fmt.Println("        League to query: nba, wnba or gleague (default: nba)")
+	fmt.Println("  -team string")
+	fmt.Println("        Only include games involving these teams (e.g., LAL,BOS)")
+	fmt.Println("  -conference string")
+	fmt.Println("        Only include games involving a team from this conference (East, West)")
+	fmt.Println("  -division string")
+	fmt.Println("        Only include games involving a team from this division (e.g., Pacific)")
+	fmt.Println("  -status string")
+	fmt.Println("        Only include games with these statuses (Scheduled, Live, Final)")
+	fmt.Println("  -predict")
+	fmt.Println("        Predict win probabilities for a date's scheduled games (allows future dates)")
+	fmt.Println("  -no-spoilers")
+	fmt.Println("        Hide scores, winners and margins; show status and watchability instead")
+	fmt.Println("  -fatigue")
+	fmt.Println("        Add each team's rest, back-to-backs and travel to every game")
+	fmt.Println("  -feats")
+	fmt.Println("        Tag double-doubles, triple-doubles and other feats, with season counts")
+	fmt.Println("  -odds string")
+	fmt.Println("        Closing lines CSV or JSON file to join by game ID, or by date and teams")
+	fmt.Println("  -flow")
+	fmt.Println("        Add lead changes, largest leads, scoring runs and comebacks from play-by-play")
 	fmt.Println("  -help")
 	fmt.Println("        Show this help message")
 	fmt.Println()
 	fmt.Println("Examples:")
-	fmt.Println("  go run main.go                              # Get today's games")
-	fmt.Println("  go run main.go -date 2024-01-15             # Get games for specific date")
-	fmt.Println("  go run main.go -start-date 2024-01-15 -end-date 2024-01-17  # Get games for date range")
-	fmt.Println("  go run main.go -output results.json         # Custom output file")
-	fmt.Println("  go run main.go -excel report.xlsx           # Custom Excel file")
+	fmt.Println("  go run .                              # Get today's games")
+	fmt.Println("  go run . -date 2024-01-15             # Get games for specific date")
+	fmt.Println("  go run . -start-date 2024-01-15 -end-date 2024-01-17  # Get games for date range")
+	fmt.Println("  go run . -league wnba -date 2024-07-10  # Get WNBA games for a date")
+	fmt.Println("  go run . -team LAL,BOS -status Final  # Only completed Lakers or Celtics games")
+	fmt.Println("  go run . h2h -teams LAL,BOS -season 2023-24  # Head-to-head meetings")
+	fmt.Println("  go run . gamelog -team LAL -last 10      # A team's last 10 games")
+	fmt.Println("  go run . streaks -season 2023-24         # Streaks and milestones")
+	fmt.Println("  go run . elo -season 2023-24             # Elo power rankings")
+	fmt.Println("  go run . standings -season 2023-24       # Standings with strength of schedule")
+	fmt.Println("  go run . -date 2024-01-15 -no-spoilers   # Status and watchability, no scores")
+	fmt.Println("  go run . -predict -date 2026-12-25       # Win probabilities for upcoming games")
+	fmt.Println("  go run . preview -date 2026-12-25         # Preview cards for upcoming games")
+	fmt.Println("  go run . calibration -season 2023-24     # How well predictions matched results")
+	fmt.Println("  go run . fatigue -season 2023-24         # Records on back-to-backs and long trips")
+	fmt.Println("  go run . trends -season 2023-24          # Home-court, quarter and weekly trends")
+	fmt.Println("  go run . teamstats -team LAL -per-game   # Pace, ratings and four factors")
+	fmt.Println("  go run . leaders -season 2023-24 -top 5  # League leaders and top performers")
+	fmt.Println("  go run . player -name jokic -last 10     # A player's last 10 games")
+	fmt.Println("  go run . -date 2024-01-15 -feats         # Triple-doubles and other feats of the day")
+	fmt.Println("  go run . feats -season 2023-24           # Feat counts per player")
+	fmt.Println("  go run . -date 2024-01-15 -odds lines.csv  # Spreads, totals and who covered")
+	fmt.Println("  go run . ats -odds lines.csv -season 2023-24  # Team records against the spread")
+	fmt.Println("  go run . pool -picks picks.csv           # Pick'em pool leaderboards")
+	fmt.Println("  go run . bracket -picks bracket.csv      # Bracket challenge standings")
+	fmt.Println("  go run . -date 2024-01-15 -flow          # Lead changes, runs and comebacks")
+	fmt.Println("  go run . comebacks -season 2023-24       # Biggest comebacks of the season")
+	fmt.Println("  go run . fantasy -profile 9cat           # Nine-category fantasy rankings")
+	fmt.Println("  go run . matchup -roster rosters.json    # Weekly fantasy matchups and projections")
+	fmt.Println("  go run . pythag -exponent 16.5           # Lucky and unlucky records")
+	fmt.Println("  go run . best -week -date 2024-01-21     # Most watchable games of the week")
+	fmt.Println("  go run . recap -date 2024-01-15 -format text  # Daily digest for the morning post")
+	fmt.Println("  go run . simulate -iterations 10000 -seed 42  # Playoff odds for the current season")
+	fmt.Println("  go run . -output results.json         # Custom output file")
+	fmt.Println("  go run . -excel report.xlsx           # Custom Excel file")
 }
\ No newline at end of file